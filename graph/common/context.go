@@ -5,19 +5,33 @@ import (
 	"context"
 	"net/http"
 
+	"github.com/gorilla/websocket"
 	"gorm.io/gorm"
 )
 
 type CustomContext struct {
 	Database *gorm.DB
+	// Events carries cell changes to subscriptions, mutations publish to it once their changes are committed.
+	Events *EventBus
+	// Author is who makes the changes of a request as given by its AuthorHeader, recorded with each version.
+	Author string
+	// Websocket is set for requests upgraded to a websocket, the only transport that can report errors to a
+	// subscription after it has started.
+	Websocket bool
 }
 
 var customContextKey string = "CUSTOM_CONTEXT"
 
+// AuthorHeader names who makes the changes of a request.
+const AuthorHeader = "X-Author"
+
 func CreateContext(args *CustomContext, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		customContext := &CustomContext{
-			Database: args.Database,
+			Database:  args.Database,
+			Events:    args.Events,
+			Author:    r.Header.Get(AuthorHeader),
+			Websocket: websocket.IsWebSocketUpgrade(r),
 		}
 		requestWithCtx := r.WithContext(context.WithValue(r.Context(), customContextKey, customContext))
 		// TODO: remove this but chi isn't working
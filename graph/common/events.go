@@ -0,0 +1,120 @@
+// @/graph/common/events.go
+package common
+
+import (
+	"context"
+	"gorm.io/gorm"
+	"log"
+	"sync"
+	"time"
+)
+
+// KeepAliveInterval is how often websocket connections are pinged, subscriptions that keep state alive such
+// as presence refresh it at the same pace.
+const KeepAliveInterval = 15 * time.Second
+
+// Event tells the subscribers of a spreadsheet that its cells changed.
+type Event struct {
+	SpreadsheetID string `json:"spreadsheetId"`
+	// Version is the version the changed cells were saved under, 0 when it is not known.
+	Version uint64 `json:"version"`
+	// Presence is set when the collaborators viewing the spreadsheet changed rather than its cells, such
+	// events only reach presence subscribers.
+	Presence bool `json:"presence,omitempty"`
+}
+
+// topic is what a subscriber waits on, the cells or the presence of a spreadsheet.
+type topic struct {
+	spreadsheetID string
+	presence      bool
+}
+
+func topicOf(event Event) topic {
+	return topic{spreadsheetID: event.SpreadsheetID, presence: event.Presence}
+}
+
+// EventBus passes events from the mutations of this process to the subscriptions waiting on them, and with
+// ListenPostgres to those of every other instance.
+type EventBus struct {
+	mu          sync.Mutex
+	subscribers map[topic]map[chan Event]struct{}
+	// notifier is set while the bus is listening for notifications, events are then published through it
+	notifier *gorm.DB
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{subscribers: make(map[topic]map[chan Event]struct{})}
+}
+
+// Publish hands an event to every subscriber of its spreadsheet. While the bus listens for notifications the
+// event goes out to all instances through Postgres and reaches the subscribers of this one on its way back.
+func (b *EventBus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	notifier := b.notifier
+	b.mu.Unlock()
+	if notifier != nil {
+		err := notify(notifier, event)
+		if err == nil {
+			return
+		}
+		log.Printf("error notifying change of spreadsheet %s: %v", event.SpreadsheetID, err)
+	}
+	b.deliver(event)
+}
+
+// deliver hands an event to the subscribers of its spreadsheet in this process without waiting on them. A
+// subscriber that has not taken its previous event yet skips this one, it reloads the spreadsheet on that
+// event either way.
+func (b *EventBus) deliver(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers[topicOf(event)] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving the cell events of a spreadsheet. It is closed once ctx is done, and
+// never receives anything from a nil bus.
+func (b *EventBus) Subscribe(ctx context.Context, spreadsheetID string) <-chan Event {
+	return b.subscribe(ctx, topic{spreadsheetID: spreadsheetID})
+}
+
+// SubscribePresence returns a channel receiving the presence events of a spreadsheet, like Subscribe.
+func (b *EventBus) SubscribePresence(ctx context.Context, spreadsheetID string) <-chan Event {
+	return b.subscribe(ctx, topic{spreadsheetID: spreadsheetID, presence: true})
+}
+
+func (b *EventBus) subscribe(ctx context.Context, t topic) <-chan Event {
+	ch := make(chan Event, 1)
+	if b == nil {
+		go func() {
+			<-ctx.Done()
+			close(ch)
+		}()
+		return ch
+	}
+	b.mu.Lock()
+	if b.subscribers[t] == nil {
+		b.subscribers[t] = make(map[chan Event]struct{})
+	}
+	b.subscribers[t][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subscribers[t], ch)
+		if len(b.subscribers[t]) == 0 {
+			delete(b.subscribers, t)
+		}
+		close(ch)
+		b.mu.Unlock()
+	}()
+	return ch
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEventBus(t *testing.T) {
+	t.Run("delivers events to the subscribers of their spreadsheet", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		first := bus.Subscribe(ctx, "1")
+		second := bus.Subscribe(ctx, "1")
+		other := bus.Subscribe(ctx, "2")
+
+		bus.Publish(Event{SpreadsheetID: "1", Version: 5})
+		assert.Equal(t, Event{SpreadsheetID: "1", Version: 5}, <-first)
+		assert.Equal(t, Event{SpreadsheetID: "1", Version: 5}, <-second)
+		assert.Empty(t, other)
+	})
+
+	t.Run("keeps presence apart from cell changes", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cells := bus.Subscribe(ctx, "1")
+		presence := bus.SubscribePresence(ctx, "1")
+
+		bus.Publish(Event{SpreadsheetID: "1", Presence: true})
+		assert.Empty(t, cells)
+		assert.Equal(t, Event{SpreadsheetID: "1", Presence: true}, <-presence)
+
+		bus.Publish(Event{SpreadsheetID: "1", Version: 5})
+		assert.Equal(t, uint64(5), (<-cells).Version)
+		assert.Empty(t, presence)
+	})
+
+	t.Run("does not block on subscribers that are behind", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := bus.Subscribe(ctx, "1")
+
+		bus.Publish(Event{SpreadsheetID: "1", Version: 1})
+		bus.Publish(Event{SpreadsheetID: "1", Version: 2})
+		assert.Equal(t, uint64(1), (<-events).Version)
+		assert.Empty(t, events)
+	})
+
+	t.Run("closes and forgets subscriptions once their context is done", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		events := bus.Subscribe(ctx, "1")
+		cancel()
+
+		_, ok := <-events
+		assert.False(t, ok)
+		bus.mu.Lock()
+		assert.Empty(t, bus.subscribers)
+		bus.mu.Unlock()
+		bus.Publish(Event{SpreadsheetID: "1"})
+	})
+
+	t.Run("a nil bus drops events and closes subscriptions", func(t *testing.T) {
+		var bus *EventBus
+		ctx, cancel := context.WithCancel(context.Background())
+		events := bus.Subscribe(ctx, "1")
+		bus.Publish(Event{SpreadsheetID: "1"})
+		cancel()
+
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,110 @@
+// @/graph/common/notify.go
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+	"log"
+	"time"
+)
+
+// changesChannel is the Postgres notification channel instances announce their changes on.
+const changesChannel = "spreadsheet_changes"
+
+// listenRetryInterval is how long ListenPostgres waits before listening again after losing its connection.
+const listenRetryInterval = 5 * time.Second
+
+// notify announces an event to every instance listening on changesChannel.
+func notify(db *gorm.DB, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return db.Exec("SELECT pg_notify(?, ?)", changesChannel, string(payload)).Error
+}
+
+// ListenPostgres shares the events of the bus with every instance using the same database, so the
+// subscribers of one instance see the changes made through another. It holds a connection listening on
+// changesChannel, which delivers the events of all instances including this one, and publishes through
+// NOTIFY while it does. When the connection is lost events are delivered locally until it is back, and
+// every subscriber is told to reload then as changes may have been missed. It returns once ctx is done.
+func (b *EventBus) ListenPostgres(ctx context.Context, db *gorm.DB) {
+	for {
+		err := b.listen(ctx, db)
+		b.mu.Lock()
+		b.notifier = nil
+		b.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("error listening for changes: %v", err)
+		select {
+		case <-time.After(listenRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (b *EventBus) listen(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("listening needs a pgx connection, got %T", driverConn)
+		}
+		pgxConn := stdlibConn.Conn()
+		_, err := pgxConn.Exec(ctx, "LISTEN "+changesChannel)
+		if err != nil {
+			return err
+		}
+		b.mu.Lock()
+		b.notifier = db
+		b.mu.Unlock()
+		b.reloadAll()
+
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+			b.receive(notification.Payload)
+		}
+	})
+}
+
+// receive delivers an event announced by an instance to the subscribers of this one.
+func (b *EventBus) receive(payload string) {
+	var event Event
+	err := json.Unmarshal([]byte(payload), &event)
+	if err != nil {
+		log.Printf("error reading change notification %q: %v", payload, err)
+		return
+	}
+	b.deliver(event)
+}
+
+// reloadAll sends every subscriber an event for its spreadsheet, so it catches up on changes it may have missed.
+func (b *EventBus) reloadAll() {
+	b.mu.Lock()
+	topics := make([]topic, 0, len(b.subscribers))
+	for t := range b.subscribers {
+		topics = append(topics, t)
+	}
+	b.mu.Unlock()
+	for _, t := range topics {
+		b.deliver(Event{SpreadsheetID: t.spreadsheetID, Presence: t.presence})
+	}
+}
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestEventBusNotify(t *testing.T) {
+	t.Run("publishes through postgres while listening", func(t *testing.T) {
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		mock.ExpectExec(`SELECT pg_notify\(\$1, \$2\)`).WithArgs(changesChannel, `{"spreadsheetId":"1","version":5}`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		bus := NewEventBus()
+		bus.notifier = db
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := bus.Subscribe(ctx, "1")
+
+		bus.Publish(Event{SpreadsheetID: "1", Version: 5})
+		assert.NoError(t, mock.ExpectationsWereMet())
+		// the event reaches local subscribers once the notification comes back
+		assert.Empty(t, events)
+		bus.receive(`{"spreadsheetId":"1","version":5}`)
+		assert.Equal(t, Event{SpreadsheetID: "1", Version: 5}, <-events)
+	})
+
+	t.Run("delivers locally when the notification fails", func(t *testing.T) {
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		mock.ExpectExec(`SELECT pg_notify`).WillReturnError(fmt.Errorf("connection lost"))
+
+		bus := NewEventBus()
+		bus.notifier = db
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := bus.Subscribe(ctx, "1")
+
+		bus.Publish(Event{SpreadsheetID: "1", Version: 5})
+		assert.Equal(t, Event{SpreadsheetID: "1", Version: 5}, <-events)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ignores notifications it cannot read", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		events := bus.Subscribe(ctx, "1")
+
+		bus.receive("not json")
+		assert.Empty(t, events)
+	})
+
+	t.Run("tells every subscriber to reload after reconnecting", func(t *testing.T) {
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		first := bus.Subscribe(ctx, "1")
+		second := bus.Subscribe(ctx, "2")
+
+		bus.reloadAll()
+		assert.Equal(t, Event{SpreadsheetID: "1"}, <-first)
+		assert.Equal(t, Event{SpreadsheetID: "2"}, <-second)
+	})
+
+	t.Run("stops listening once its context is done", func(t *testing.T) {
+		mockDB, _, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+
+		bus := NewEventBus()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		bus.ListenPostgres(ctx, db)
+		assert.Nil(t, bus.notifier)
+	})
+}
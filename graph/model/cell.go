@@ -7,6 +7,8 @@ import (
 	"github.com/vijaykramesh/gql-sheets/graph/common"
 	"github.com/xuri/efp"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -17,11 +19,18 @@ type Cell struct {
 	gorm.Model
 	SpreadsheetID string       `json:"spreadsheetId"`
 	Spreadsheet   *Spreadsheet `json:"spreadsheet"`
+	SheetID       string       `json:"sheetId"`
 	RawValue      string       `json:"rawValue"`
 	ComputedValue string       `json:"computedValue,omitempty"`
+	ComputedType  ValueType    `json:"computedType"`
+	ErrorMessage  string       `json:"errorMessage,omitempty"`
 	RowIndex      int          `json:"rowIndex"`
 	ColumnIndex   int          `json:"columnIndex"`
 	Version       uint64       `json:"version"`
+	// DeletedVersion is the version that deleted the cell, set along with DeletedAt.
+	DeletedVersion *uint64 `json:"-" gorm:"<-:update"`
+	// CycleCells lists the cells of circular references found while saving this cell, it is not stored.
+	CycleCells []string `json:"cycleCells,omitempty" gorm:"-"`
 }
 
 func (c *Cell) parseRawValue() ([]efp.Token, error) {
@@ -33,108 +42,60 @@ func (c *Cell) parseRawValue() ([]efp.Token, error) {
 	return ps.Tokens.Items, nil
 }
 
+// ComputeValueFromRaw returns the computed value as it is displayed, for error values such as #DIV/0!
+// the *FormulaError describing it is returned as well.
 func (c *Cell) ComputeValueFromRaw(otherCells []Cell) (string, error) {
+	value := c.computeValue(otherCells, Spreadsheet{})
+	if value.Type == ValueTypeError {
+		return value.String(), value.Error
+	}
+	return value.String(), nil
+}
+
+// computeValue evaluates a formula against otherCells, any other raw value has its type inferred.
+// Whole column and row ranges end at the last row and column of their sheet, or at the last cell written
+// when the size is unknown. References to other sheets and names are resolved through spreadsheet.
+func (c *Cell) computeValue(otherCells []Cell, spreadsheet Spreadsheet) Value {
 	// if c.RawValue starts with =, then it is a formula or reference
 	if len(c.RawValue) > 0 && c.RawValue[0] == '=' {
-		tokens, err := c.parseRawValue()
+		formula, err := c.parseFormula()
 		if err != nil {
-			return "", err
+			return ErrorValue(ErrorCodeParse, err.Error())
 		}
-
-		// =A4 style reference, lookup the computed value of the referenced cell
-		if len(tokens) == 1 && tokens[0].TType == "Operand" && tokens[0].TSubType == "Range" {
-			computedValue, lookupErr := referenceLookup(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
+		e := &evaluator{cell: c, cells: otherCells, spreadsheet: spreadsheet}
+		value, err := e.evaluate(formula)
+		if err == nil {
+			value, err = value.scalar()
 		}
-		// =SUM(A1:A3) style formula, compute the value
-		if len(tokens) == 3 &&
-			tokens[0].TType == "Function" &&
-			tokens[0].TSubType == "Start" &&
-			tokens[0].TValue == "SUM" &&
-			tokens[1].TType == "Operand" &&
-			tokens[1].TSubType == "Range" &&
-			tokens[2].TType == "Function" &&
-			tokens[2].TSubType == "Stop" &&
-			tokens[2].TValue == "" {
-			computedValue, lookupErr := sumRange(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
-		}
-
-		// =AVERAGE(A1:A3) style formula, compute the value
-		if len(tokens) == 3 &&
-			tokens[0].TType == "Function" &&
-			tokens[0].TSubType == "Start" &&
-			tokens[0].TValue == "AVERAGE" &&
-			tokens[1].TType == "Operand" &&
-			tokens[1].TSubType == "Range" &&
-			tokens[2].TType == "Function" &&
-			tokens[2].TSubType == "Stop" &&
-			tokens[2].TValue == "" {
-			computedValue, lookupErr := averageRange(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
+		if err != nil {
+			return errorValueFromError(err)
 		}
+		return value
+	}
+	return parseValue(c.RawValue)
+}
 
-		// =MAX(A1:A4) style formula, compute the value
-		if len(tokens) == 3 &&
-			tokens[0].TType == "Function" &&
-			tokens[0].TSubType == "Start" &&
-			tokens[0].TValue == "MAX" &&
-			tokens[1].TType == "Operand" &&
-			tokens[1].TSubType == "Range" &&
-			tokens[2].TType == "Function" &&
-			tokens[2].TSubType == "Stop" &&
-			tokens[2].TValue == "" {
-			computedValue, lookupErr := maxRange(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
-		}
+// setComputedValue evaluates the cell and stores the result along with its type.
+func (c *Cell) setComputedValue(otherCells []Cell, spreadsheet Spreadsheet) {
+	c.setValue(c.computeValue(otherCells, spreadsheet))
+}
 
-		// =MAX(A1:A4) style formula, compute the value
-		if len(tokens) == 3 &&
-			tokens[0].TType == "Function" &&
-			tokens[0].TSubType == "Start" &&
-			tokens[0].TValue == "MIN" &&
-			tokens[1].TType == "Operand" &&
-			tokens[1].TSubType == "Range" &&
-			tokens[2].TType == "Function" &&
-			tokens[2].TSubType == "Stop" &&
-			tokens[2].TValue == "" {
-			computedValue, lookupErr := minRange(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
-		}
-		// =COUNT(A1:A3) style formula, compute the value
-		if len(tokens) == 3 &&
-			tokens[0].TType == "Function" &&
-			tokens[0].TSubType == "Start" &&
-			tokens[0].TValue == "COUNT" &&
-			tokens[1].TType == "Operand" &&
-			tokens[1].TSubType == "Range" &&
-			tokens[2].TType == "Function" &&
-			tokens[2].TSubType == "Stop" &&
-			tokens[2].TValue == "" {
-			computedValue, lookupErr := countRange(tokens, otherCells)
-			if lookupErr != nil {
-				return "ERROR", lookupErr
-			}
-			return computedValue, nil
-		}
+func (c *Cell) setValue(value Value) {
+	c.ComputedValue = value.String()
+	c.ComputedType = value.Type
+	c.ErrorMessage = ""
+	if value.Type == ValueTypeError {
+		c.ErrorMessage = value.Error.Message
+	}
+}
 
+// TypedValue returns the computed value of the cell as the type it was computed as.
+func (c *Cell) TypedValue() Value {
+	value := parseTypedValue(c.ComputedType, c.ComputedValue)
+	if value.Type == ValueTypeError && c.ErrorMessage != "" {
+		value.Error.Message = c.ErrorMessage
 	}
-	return c.RawValue, nil
+	return value
 }
 
 func buildLatestVersionSeenForColumnAndRowIndex(otherCells []Cell) map[string]uint64 {
@@ -158,227 +119,380 @@ func buildOnlyLatestVersionOtherCells(otherCells []Cell, latestVersionSeenForCol
 	}
 	return onlyLatestVersionOtherCells
 }
-func (c *Cell) UpdateCellAndDependentCells(context *common.CustomContext, input UpdateCell) (*Cell, error) {
-	version := uint64(time.Now().UnixMilli())
-	c.RawValue = input.RawValue
 
-	var otherCells []Cell
-	err := context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("column_index,row_index,max(version) as version").Group("column_index,row_index"))).Where("spreadsheet_id = ? AND (column_index != ? OR row_index != ?) AND version = (SELECT version FROM cte WHERE column_index = cells.column_index AND row_index = cells.row_index)", c.SpreadsheetID, c.ColumnIndex, c.RowIndex).Find(&otherCells).Error
+// latestCells loads the latest version of every cell in a spreadsheet, across all of its sheets.
+func latestCells(db *gorm.DB, spreadsheetID string) ([]Cell, error) {
+	var cells []Cell
+	err := db.Clauses(exclause.NewWith("cte", db.Table("cells").Select("sheet_id,column_index,row_index,max(version) as version").Where("spreadsheet_id = ? AND deleted_at IS NULL", spreadsheetID).Group("sheet_id,column_index,row_index"))).Where("spreadsheet_id = ? AND version = (SELECT version FROM cte WHERE sheet_id = cells.sheet_id AND column_index = cells.column_index AND row_index = cells.row_index)", spreadsheetID).Find(&cells).Error
 	if err != nil {
-		return nil, fmt.Errorf("error getting cells: %v", err)
+		return nil, err
+	}
+	cellsBySheet := make(map[string][]Cell)
+	var sheetIDs []string
+	for _, cell := range cells {
+		if _, ok := cellsBySheet[cell.SheetID]; !ok {
+			sheetIDs = append(sheetIDs, cell.SheetID)
+		}
+		cellsBySheet[cell.SheetID] = append(cellsBySheet[cell.SheetID], cell)
+	}
+	latest := make([]Cell, 0, len(cells))
+	for _, sheetID := range sheetIDs {
+		sheetCells := cellsBySheet[sheetID]
+		latest = append(latest, buildOnlyLatestVersionOtherCells(sheetCells, buildLatestVersionSeenForColumnAndRowIndex(sheetCells))...)
 	}
-	latestVersionSeenForColumnAndRowIndex := buildLatestVersionSeenForColumnAndRowIndex(otherCells)
-	onlyLatestVersionOtherCells := buildOnlyLatestVersionOtherCells(otherCells, latestVersionSeenForColumnAndRowIndex)
+	return latest, nil
+}
 
-	c.ComputedValue, err = c.ComputeValueFromRaw(onlyLatestVersionOtherCells)
+// loadCalculation loads what recalculating a spreadsheet needs, the spreadsheet with its sheets and named
+// ranges and the latest version of every cell. The spreadsheet is locked until the transaction of db ends, so
+// no other change is saved between loading the cells and saving what was calculated from them.
+func loadCalculation(db *gorm.DB, spreadsheetID string) (Spreadsheet, []Cell, error) {
+	var spreadsheet Spreadsheet
+	err := db.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", spreadsheetID).First(&spreadsheet).Error
+	if err != nil {
+		return Spreadsheet{}, nil, fmt.Errorf("error getting spreadsheet: %v", err)
+	}
+	spreadsheet.Sheets, err = LoadSheets(db, spreadsheetID)
+	if err != nil {
+		return Spreadsheet{}, nil, err
+	}
+	spreadsheet.NamedRanges, err = LoadNamedRanges(db, spreadsheetID)
+	if err != nil {
+		return Spreadsheet{}, nil, err
+	}
+	cells, err := latestCells(db, spreadsheetID)
+	if err != nil {
+		return Spreadsheet{}, nil, fmt.Errorf("error getting cells: %v", err)
+	}
+	return spreadsheet, cells, nil
+}
+
+// saveRecalculation recalculates the changed cells and everything that depends on them and saves each
+// recomputed cell under version.
+func saveRecalculation(db *gorm.DB, cells []Cell, changed []cellKey, spreadsheet Spreadsheet, version uint64) ([]*Cell, [][]cellKey, error) {
+	recomputed, cycles := recalculate(cells, changed, spreadsheet)
+	for _, cell := range recomputed {
+		err := cell.saveVersion(db, version)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error updating cell: %v", err)
+		}
+	}
+	return recomputed, cycles, nil
+}
+
+// withCell returns cells with the cell at c's position replaced by c, or c appended if the position is empty.
+func withCell(cells []Cell, c Cell) []Cell {
+	for i := range cells {
+		if keyOf(&cells[i]) == keyOf(&c) {
+			cells[i] = c
+			return cells
+		}
+	}
+	return append(cells, c)
+}
+
+// saveVersion writes the cell as a new row at version, earlier rows are kept so the cell can be reverted.
+func (c *Cell) saveVersion(db *gorm.DB, version uint64) error {
+	c.ID = 0
+	c.CreatedAt = time.Time{}
+	c.UpdatedAt = time.Time{}
 	c.Version = version
-	err = context.Database.Omit("id").Create(&c).Error
-	// select cell we just saved and set to c
-	newC := Cell{}
-	err = context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("column_index,row_index,max(version) as version").Group("column_index,row_index"))).Where("spreadsheet_id = ? AND column_index = ? and row_index = ? AND version = (SELECT version FROM cte WHERE column_index = cells.column_index AND row_index = cells.row_index)", c.SpreadsheetID, c.ColumnIndex, c.RowIndex).First(&newC).Error
+	return db.Create(c).Error
+}
+
+// UpdateCellAndDependentCells sets the raw value of c and recalculates every cell that depends on it, directly
+// or through other formulas. Each affected cell is computed once, after its precedents, and all of them are
+// saved under the same version. Cells in circular references are listed in the returned cell's CycleCells.
+// When the input has an expected version that the cell has moved past, a ConflictError is returned instead
+// unless the sheet lets the last writer win.
+func (c *Cell) UpdateCellAndDependentCells(context *common.CustomContext, input UpdateCell) (*Cell, error) {
+	expectedVersion, err := parseExpectedVersion(input.ExpectedVersion)
 	if err != nil {
-		return nil, fmt.Errorf("error updating cell: %v", err)
+		return nil, err
 	}
+	c.RawValue = input.RawValue
 
-	onlyLatestVersionOtherCells = append(onlyLatestVersionOtherCells, newC)
-	dependentCells, err := c.FindDependentCells(onlyLatestVersionOtherCells)
-	for _, dependentCell := range dependentCells {
-		dependentCell.ComputedValue, err = dependentCell.ComputeValueFromRaw(onlyLatestVersionOtherCells)
+	var version uint64
+	err = context.Database.Transaction(func(tx *gorm.DB) error {
+		spreadsheet, cells, err := loadCalculation(tx, c.SpreadsheetID)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		dependentCell.Version = version
-		err = context.Database.Omit("id").Create(&dependentCell).Error
+		if c.SheetID == "" && len(spreadsheet.Sheets) > 0 {
+			c.SheetID = spreadsheet.Sheets[0].id()
+		}
+		err = checkConflict(spreadsheet, cells, *c, expectedVersion)
+		if err != nil {
+			return err
+		}
+		version, err = NextVersion(tx, context, c.SpreadsheetID, cellSummary(spreadsheet, *c), false)
 		if err != nil {
-			return nil, fmt.Errorf("error updating cell: %v", err)
+			return err
 		}
+		cells = withCell(cells, *c)
 
-		// todo change this to recurse and support infinite reference depth
-		filteredOtherCells := []Cell{}
-		for _, otherCell := range onlyLatestVersionOtherCells {
-			if otherCell.ID != dependentCell.ID {
-				filteredOtherCells = append(filteredOtherCells, otherCell)
+		recomputed, cycles, err := saveRecalculation(tx, cells, []cellKey{keyOf(c)}, spreadsheet, version)
+		if err != nil {
+			return err
+		}
+		for _, cell := range recomputed {
+			if keyOf(cell) == keyOf(c) {
+				*c = *cell
 			}
 		}
-		filteredOtherCells = append(filteredOtherCells, dependentCell)
-		dependentCells, err = dependentCell.FindDependentCells(filteredOtherCells)
-		for _, dc := range dependentCells {
-			dc.ComputedValue, err = dc.ComputeValueFromRaw(filteredOtherCells)
-			if err != nil {
-				return nil, err
+		c.CycleCells = nil
+		for _, cycle := range cycles {
+			for _, key := range cycle {
+				c.CycleCells = append(c.CycleCells, key.code())
 			}
-			dc.Version = version
-			err = context.Database.Omit("id").Create(&dc).Error
 		}
-
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
+	context.Events.Publish(common.Event{SpreadsheetID: c.SpreadsheetID, Version: version})
 	return c, nil
 }
 
-func referenceLookup(tokens []efp.Token, otherCells []Cell) (string, error) {
-	lookupCell := tokens[0].TValue
-	columnIndex, rowIndex, err := columnAndRowIndexFromCode(lookupCell)
-	if err != nil {
-		return "", err
+// UpdateCells sets the raw values of many cells of a spreadsheet at once, as when a block is pasted. The cells
+// and everything depending on them are recalculated in a single pass and saved under one version, so the
+// whole batch is reverted together. A later update of the same position wins. The returned cells are every cell
+// that was saved, the updated ones followed by their dependents, and cells in circular references list the
+// cells of their cycle in CycleCells. A conflict with the expected version of any update rejects the batch.
+// An empty batch saves nothing and creates no version.
+func UpdateCells(context *common.CustomContext, spreadsheetID string, updates []*CellUpdate) ([]*Cell, error) {
+	if len(updates) == 0 {
+		return []*Cell{}, nil
 	}
-
-	var matchedCell *Cell
-	for _, cell := range otherCells {
-		if cell.ColumnIndex == columnIndex && cell.RowIndex == rowIndex {
-			matchedCell = &cell
-			break
+	expectedVersions := make([]*uint64, len(updates))
+	for i, update := range updates {
+		var err error
+		expectedVersions[i], err = parseExpectedVersion(update.ExpectedVersion)
+		if err != nil {
+			return nil, err
 		}
 	}
-	if matchedCell == nil {
-		return "", fmt.Errorf("could not find cell %s", lookupCell)
-	}
-	return matchedCell.ComputedValue, nil
-}
-func averageRange(tokens []efp.Token, otherCells []Cell) (string, error) {
-	// Compute average
-	var sum float64
-	var count int64
-	for _, cell := range otherCells {
-		check, err := checkIfCellInRange(&cell, tokens[1].TValue)
+	var version uint64
+	var recomputed []*Cell
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		spreadsheet, cells, err := loadCalculation(tx, spreadsheetID)
 		if err != nil {
-			return "", err
+			return err
 		}
-		if check {
-			cellValue, err := strconv.ParseFloat(cell.ComputedValue, 64)
-			if err == nil {
-				sum += cellValue
-				count++
+		// versions are checked against the cells from before the batch, not earlier updates of the batch
+		current := append([]Cell(nil), cells...)
+		var changed []cellKey
+		var last Cell
+		updated := make(map[cellKey]bool, len(updates))
+		for i, update := range updates {
+			var sheet Sheet
+			var ok bool
+			if update.SheetID == nil {
+				ok = len(spreadsheet.Sheets) > 0
+				if ok {
+					sheet = spreadsheet.Sheets[0]
+				}
+			} else {
+				sheet, ok = spreadsheet.sheetByID(*update.SheetID)
+			}
+			if !ok {
+				return fmt.Errorf("sheet not found in spreadsheet %s", spreadsheetID)
+			}
+			err = ValidateSheetRowAndColumnIndexes(sheet, update.RowIndex, update.ColumnIndex)
+			if err != nil {
+				return err
+			}
+			cell := Cell{SpreadsheetID: spreadsheetID, SheetID: sheet.id(), ColumnIndex: update.ColumnIndex, RowIndex: update.RowIndex, RawValue: update.RawValue}
+			err = checkConflict(spreadsheet, current, cell, expectedVersions[i])
+			if err != nil {
+				return err
+			}
+			cells = withCell(cells, cell)
+			last = cell
+			if !updated[keyOf(&cell)] {
+				updated[keyOf(&cell)] = true
+				changed = append(changed, keyOf(&cell))
 			}
 		}
-	}
-	average := sum / float64(count)
-	return strconv.FormatFloat(average, 'f', -1, 64), nil
-}
-
-func sumRange(tokens []efp.Token, otherCells []Cell) (string, error) {
-
-	var sum int64
-	for _, cell := range otherCells {
-		check, e := checkIfCellInRange(&cell, tokens[1].TValue)
-		if e != nil {
-			return "", e
+		summary := fmt.Sprintf("Set %d cells", len(changed))
+		if len(changed) == 1 {
+			summary = cellSummary(spreadsheet, last)
 		}
-		if check {
-			cellValue, err := strconv.ParseInt(cell.ComputedValue, 10, 64)
-			if err == nil {
-				sum += cellValue
-			}
+		version, err = NextVersion(tx, context, spreadsheetID, summary, false)
+		if err != nil {
+			return err
 		}
-	}
-	return strconv.FormatInt(sum, 10), nil
-}
-func maxRange(tokens []efp.Token, otherCells []Cell) (string, error) {
-	var max int64
-	isFirstValue := true
 
-	for _, cell := range otherCells {
-		check, err := checkIfCellInRange(&cell, tokens[1].TValue)
+		var cycles [][]cellKey
+		recomputed, cycles, err = saveRecalculation(tx, cells, changed, spreadsheet, version)
 		if err != nil {
-			return "", err
+			return err
 		}
-		if check {
-			cellValue, err := strconv.ParseInt(cell.ComputedValue, 10, 64)
-			if err == nil {
-				if isFirstValue || cellValue > max {
-					max = cellValue
-					isFirstValue = false
-				}
+		cycleOf := make(map[cellKey][]string)
+		for _, cycle := range cycles {
+			codes := make([]string, len(cycle))
+			for i, key := range cycle {
+				codes[i] = key.code()
+			}
+			for _, key := range cycle {
+				cycleOf[key] = codes
 			}
 		}
+		for _, cell := range recomputed {
+			cell.CycleCells = cycleOf[keyOf(cell)]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return strconv.FormatInt(max, 10), nil
+	context.Events.Publish(common.Event{SpreadsheetID: spreadsheetID, Version: version})
+	return recomputed, nil
 }
 
-func minRange(tokens []efp.Token, otherCells []Cell) (string, error) {
-	var min int64
-	isFirstValue := true
+func referenceLookup(reference string, otherCells []Cell) (*Cell, error) {
+	columnIndex, rowIndex, err := columnAndRowIndexFromCode(reference)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, cell := range otherCells {
-		check, err := checkIfCellInRange(&cell, tokens[1].TValue)
-		if err != nil {
-			return "", err
-		}
-		if check {
-			cellValue, err := strconv.ParseInt(cell.ComputedValue, 10, 64)
-			if err == nil {
-				if isFirstValue || cellValue < min {
-					min = cellValue
-					isFirstValue = false
-				}
-			}
+	for i := range otherCells {
+		if otherCells[i].ColumnIndex == columnIndex && otherCells[i].RowIndex == rowIndex {
+			return &otherCells[i], nil
 		}
 	}
+	return nil, fmt.Errorf("could not find cell %s", reference)
+}
 
-	return strconv.FormatInt(min, 10), nil
+// cellRange is the inclusive bounds of an A1:B3 style range. Whole column, whole row and open ended ranges
+// such as A:A, 3:3 or B2:B end at openRangeEnd until they are clamped to the size of a spreadsheet.
+type cellRange struct {
+	// sheet is the ID of the sheet the range is in, empty while it is not resolved
+	sheet       string
+	startColumn int
+	startRow    int
+	endColumn   int
+	endRow      int
 }
 
-func countRange(tokens []efp.Token, otherCells []Cell) (string, error) {
-	count := int64(0)
-	for _, cell := range otherCells {
-		check, err := checkIfCellInRange(&cell, tokens[1].TValue)
-		if err != nil {
-			return "", err
-		}
-		if check {
-			count += 1
+// openRangeEnd is the end column or row of a range that runs to the edge of the spreadsheet.
+const openRangeEnd = math.MaxInt32
+
+var (
+	columnCodePattern = regexp.MustCompile(`^\$?[A-Z]+$`)
+	rowCodePattern    = regexp.MustCompile(`^\$?\d+$`)
+)
+
+// parseRangeEnd reads one side of a range, a cell code, a column code such as B or a row number such as 3.
+// A column or row that is left out is -1.
+func parseRangeEnd(code string) (int, int, error) {
+	switch {
+	case columnCodePattern.MatchString(code):
+		column, _, err := columnAndRowIndexFromCode(code + "1")
+		return column, -1, err
+	case rowCodePattern.MatchString(code):
+		row, err := strconv.Atoi(strings.TrimPrefix(code, "$"))
+		if err != nil || row < 1 {
+			return -1, -1, fmt.Errorf("invalid row %s", code)
 		}
+		return -1, row - 1, nil
 	}
-	return strconv.FormatInt(count, 10), nil
+	return columnAndRowIndexFromCode(code)
 }
 
-func checkIfCellInRange(c *Cell, tvalue string) (bool, error) {
+// parseRange reads A1:B3 style ranges as well as whole columns (B:B), whole rows (2:2) and ranges that are
+// open ended towards the last row (B2:B) or column (B2:2).
+func parseRange(tvalue string) (cellRange, error) {
 	if len(strings.Split(tvalue, ":")) != 2 {
-		return false, fmt.Errorf("invalid range %s", tvalue)
+		return cellRange{}, fmt.Errorf("invalid range %s", tvalue)
 	}
 	startCell := strings.Split(tvalue, ":")[0]
 	endCell := strings.Split(tvalue, ":")[1]
 
-	startColumnIndex, startRowIndex, err := columnAndRowIndexFromCode(startCell)
+	startColumnIndex, startRowIndex, err := parseRangeEnd(startCell)
 	if err != nil {
-		return false, err
+		return cellRange{}, err
 	}
-	endColumnIndex, endRowIndex, err := columnAndRowIndexFromCode(endCell)
+	endColumnIndex, endRowIndex, err := parseRangeEnd(endCell)
 	if err != nil {
-		return false, err
+		return cellRange{}, err
+	}
+	// a side without a column or row covers all of them, which only works when the other side does as well
+	if (startColumnIndex < 0 && endColumnIndex >= 0) || (startRowIndex < 0 && endRowIndex >= 0) {
+		return cellRange{}, fmt.Errorf("invalid range %s", tvalue)
 	}
-	if c.ColumnIndex >= startColumnIndex && c.ColumnIndex <= endColumnIndex && c.RowIndex >= startRowIndex && c.RowIndex <= endRowIndex {
-		return true, nil
+	if startColumnIndex < 0 {
+		startColumnIndex = 0
 	}
-	return false, nil
+	if startRowIndex < 0 {
+		startRowIndex = 0
+	}
+	if endColumnIndex < 0 {
+		endColumnIndex = openRangeEnd
+	}
+	if endRowIndex < 0 {
+		endRowIndex = openRangeEnd
+	}
+	// B3:A1 covers the same cells as A1:B3
+	if startColumnIndex > endColumnIndex {
+		startColumnIndex, endColumnIndex = endColumnIndex, startColumnIndex
+	}
+	if startRowIndex > endRowIndex {
+		startRowIndex, endRowIndex = endRowIndex, startRowIndex
+	}
+	return cellRange{startColumn: startColumnIndex, startRow: startRowIndex, endColumn: endColumnIndex, endRow: endRowIndex}, nil
 }
 
+// clamp bounds open ended ranges to the last row and column given, a range past them keeps its first row or column.
+func (r cellRange) clamp(lastColumn int, lastRow int) cellRange {
+	if r.endColumn == openRangeEnd {
+		r.endColumn = lastColumn
+		if r.endColumn < r.startColumn {
+			r.endColumn = r.startColumn
+		}
+	}
+	if r.endRow == openRangeEnd {
+		r.endRow = lastRow
+		if r.endRow < r.startRow {
+			r.endRow = r.startRow
+		}
+	}
+	return r
+}
+
+func (r cellRange) contains(c *Cell) bool {
+	return c.SheetID == r.sheet && c.ColumnIndex >= r.startColumn && c.ColumnIndex <= r.endColumn && c.RowIndex >= r.startRow && c.RowIndex <= r.endRow
+}
+
+func checkIfCellInRange(c *Cell, tvalue string) (bool, error) {
+	bounds, err := parseRange(tvalue)
+	if err != nil {
+		return false, err
+	}
+	return bounds.contains(c), nil
+}
+
+// FindDependentCells returns the cells in otherCells whose formulas reference c, either directly or through a range.
 func (c *Cell) FindDependentCells(otherCells []Cell) ([]Cell, error) {
+	isDependent := make(map[cellKey]bool)
+	for _, key := range buildDependencyGraph(otherCells, Spreadsheet{}).directDependents(keyOf(c)) {
+		isDependent[key] = true
+	}
 	var dependentCells []Cell
 	for _, cell := range otherCells {
-		tokens, err := cell.parseRawValue()
-
-		// =A4 style reference, check if c is the referenced cell and if so add cell to dependent cells
-		cToken := columnCodeFromColumnIndex(c.ColumnIndex) + strconv.Itoa(c.RowIndex+1)
-		if err == nil && len(tokens) == 1 && tokens[0].TType == "Operand" && tokens[0].TSubType == "Range" && tokens[0].TValue == cToken {
+		if isDependent[keyOf(&cell)] {
 			dependentCells = append(dependentCells, cell)
 		}
-		// =SUM(A1:A3) style formula, check if c is in the range and if so add cell to dependent cells
-		if err == nil && len(tokens) == 3 && tokens[0].TType == "Function" && tokens[0].TSubType == "Start" && (tokens[0].TValue == "SUM" || tokens[0].TValue == "AVERAGE") && tokens[1].TType == "Operand" && tokens[1].TSubType == "Range" && tokens[2].TType == "Function" && tokens[2].TSubType == "Stop" && tokens[2].TValue == "" {
-			check, _ := checkIfCellInRange(c, tokens[1].TValue)
-			if check {
-				dependentCells = append(dependentCells, cell)
-			}
-		}
 	}
 	return dependentCells, nil
-
 }
 
+// columnAndRowIndexFromCode returns the zero based column and row of an A1 style code. The $ anchors of
+// absolute and mixed references such as $A$1 or A$1 are accepted and ignored, see parseCellReference.
 func columnAndRowIndexFromCode(code string) (int, int, error) {
 	// Use regular expression to split the code into letters and numbers
-	re := regexp.MustCompile(`^([A-Z]+)(\d+)$`)
+	re := regexp.MustCompile(`^\$?([A-Z]+)\$?(\d+)$`)
 	matches := re.FindStringSubmatch(code)
 	if len(matches) != 3 {
 		return -1, -1, fmt.Errorf("invalid code format: %s", code)
@@ -387,14 +501,14 @@ func columnAndRowIndexFromCode(code string) (int, int, error) {
 	letters := matches[1]
 	numbers := matches[2]
 
-	// Compute the column index based on letters
+	// Compute the column index based on letters, read as a base 26 number where A is 1 and Z is 26
 	column := 0
 	for i := 0; i < len(letters); i++ {
 		char := letters[i]
 		if char < 'A' || char > 'Z' {
 			return -1, -1, fmt.Errorf("invalid column code: %s", code)
 		}
-		column = (i)*26 + int(char-'A'+1)
+		column = column*26 + int(char-'A'+1)
 	}
 
 	// Compute the row index based on numbers
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+)
+
+// CellChanges are the cells of a spreadsheet that changed after a version, for clients that keep a copy of the
+// spreadsheet up to date.
+type CellChanges struct {
+	// Version is the version to pass as since for the changes after these.
+	Version uint64 `json:"version"`
+	// Cells is the current cell at every position that changed, including positions a revert took back to an
+	// earlier cell.
+	Cells []*Cell `json:"cells"`
+	// DeletedCells are the cells deleted by a revert or along with their sheet that left no cell at their
+	// position.
+	DeletedCells []*Cell `json:"deletedCells"`
+}
+
+// CellChangesSince returns the cells of a spreadsheet saved after version since and the cells deleted by a later
+// version. With since 0 every current cell is returned, and deletions are left out as the client has nothing to
+// remove them from.
+func CellChangesSince(db *gorm.DB, spreadsheetID string, since uint64) (*CellChanges, error) {
+	changes := &CellChanges{Version: since, Cells: []*Cell{}, DeletedCells: []*Cell{}}
+	err := db.Select("DISTINCT ON (sheet_id, row_index, column_index) *").
+		Where("spreadsheet_id = ? AND version > ?", spreadsheetID, since).
+		Order("sheet_id, row_index, column_index, version desc").Find(&changes.Cells).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting cells: %v", err)
+	}
+	changed := make(map[cellKey]bool, len(changes.Cells))
+	for _, cell := range changes.Cells {
+		changed[keyOf(cell)] = true
+		if cell.Version > changes.Version {
+			changes.Version = cell.Version
+		}
+	}
+
+	if since == 0 {
+		return changes, nil
+	}
+
+	var deleted []*Cell
+	err = db.Unscoped().Select("DISTINCT ON (sheet_id, row_index, column_index) *").
+		Where("spreadsheet_id = ? AND deleted_version > ?", spreadsheetID, since).
+		Order("sheet_id, row_index, column_index, version desc").Find(&deleted).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting deleted cells: %v", err)
+	}
+	var positions [][]interface{}
+	for _, cell := range deleted {
+		if *cell.DeletedVersion > changes.Version {
+			changes.Version = *cell.DeletedVersion
+		}
+		if !changed[keyOf(cell)] {
+			positions = append(positions, []interface{}{cell.SheetID, cell.RowIndex, cell.ColumnIndex})
+		}
+	}
+	if len(positions) == 0 {
+		return changes, nil
+	}
+
+	// a revert leaves the earlier cells of a position in place, those are its current cell now
+	var restored []*Cell
+	err = db.Select("DISTINCT ON (sheet_id, row_index, column_index) *").
+		Where("spreadsheet_id = ? AND (sheet_id, row_index, column_index) IN ?", spreadsheetID, positions).
+		Order("sheet_id, row_index, column_index, version desc").Find(&restored).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting cells: %v", err)
+	}
+	for _, cell := range restored {
+		changed[keyOf(cell)] = true
+	}
+	changes.Cells = append(changes.Cells, restored...)
+	for _, cell := range deleted {
+		if !changed[keyOf(cell)] {
+			changes.DeletedCells = append(changes.DeletedCells, cell)
+		}
+	}
+	return changes, nil
+}
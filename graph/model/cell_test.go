@@ -2,7 +2,6 @@ package model
 
 import (
 	"github.com/stretchr/testify/assert"
-	"github.com/xuri/efp"
 	"testing"
 )
 
@@ -108,9 +107,13 @@ func TestColumnAndRowIndexFromCode(t *testing.T) {
 	assert.Equal(t, 76, row)
 
 	column, row, _ = columnAndRowIndexFromCode("ZZ100")
-	assert.Equal(t, 51, column)
+	assert.Equal(t, 701, column)
 	assert.Equal(t, 99, row)
 
+	column, row, _ = columnAndRowIndexFromCode("$BA$3")
+	assert.Equal(t, 52, column)
+	assert.Equal(t, 2, row)
+
 	// Invalid column code: empty string
 	column, row, err := columnAndRowIndexFromCode("")
 	assert.Error(t, err)
@@ -135,6 +138,14 @@ func TestColumnAndRowIndexFromCode(t *testing.T) {
 	assert.Equal(t, -1, column)
 	assert.Equal(t, -1, row)
 }
+func TestColumnCodeRoundTrip(t *testing.T) {
+	for _, code := range []string{"A", "Z", "AA", "AZ", "BA", "ZZ", "AAA", "XFD"} {
+		column, _, err := columnAndRowIndexFromCode(code + "1")
+		assert.NoError(t, err)
+		assert.Equal(t, code, columnCodeFromColumnIndex(column), code)
+	}
+}
+
 func TestColumnCodeFromColumnIndex(t *testing.T) {
 	// Valid cases
 	assert.Equal(t, "A", columnCodeFromColumnIndex(0))
@@ -189,15 +200,29 @@ func TestComputeValueFromRaw(t *testing.T) {
 		// Call the function
 		result, err := c.ComputeValueFromRaw(otherCells)
 
+		// Cells that have never been written are blank
+		if err != nil {
+			t.Errorf("Expected no error, but got: %s", err.Error())
+		}
+		if result != "" {
+			t.Errorf("Expected blank result, but got: %s", result)
+		}
+	})
+
+	t.Run("Formula or Reference - Invalid Reference", func(t *testing.T) {
+		// Set up the test case
+		c.RawValue = "=A3B"
+
+		// Call the function
+		result, err := c.ComputeValueFromRaw(otherCells)
+
 		// Check the error
-		expectedError := "could not find cell A3"
+		expectedError := "#NAME? unknown name A3B"
 		if err == nil || err.Error() != expectedError {
 			t.Errorf("Expected error: %s, but got: %s", expectedError, err)
 		}
-
-		// Check the result (should be nil)
-		if result != "ERROR" {
-			t.Errorf("Expected ERROR result, but got: %s", result)
+		if result != "#NAME?" {
+			t.Errorf("Expected #NAME? result, but got: %s", result)
 		}
 	})
 
@@ -252,31 +277,23 @@ func TestComputeValueFromRaw(t *testing.T) {
 }
 
 func TestReferenceLookup(t *testing.T) {
-	tokens := []efp.Token{
-		{TValue: "A1"},
-	}
-
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "Value1"},
 		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "Value2"},
 	}
 
-	result, err := referenceLookup(tokens, otherCells)
+	result, err := referenceLookup("A1", otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
 
 	expected := "Value1"
-	if result != expected {
-		t.Errorf("Expected result: %s, but got: %s", expected, result)
+	if result.ComputedValue != expected {
+		t.Errorf("Expected result: %s, but got: %s", expected, result.ComputedValue)
 	}
 }
 func TestSumRange(t *testing.T) {
-	tokens := []efp.Token{
-		{TType: "Function", TSubType: "Start", TValue: "SUM"},
-		{TType: "Operand", TSubType: "Range", TValue: "A1:A3"},
-		{TType: "Function", TSubType: "Stop", TValue: ""},
-	}
+	c := &Cell{RawValue: "=SUM(A1:A3)"}
 
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "10"},
@@ -285,7 +302,7 @@ func TestSumRange(t *testing.T) {
 		{ColumnIndex: 1, RowIndex: 3, ComputedValue: "40"},
 	}
 
-	result, err := sumRange(tokens, otherCells)
+	result, err := c.ComputeValueFromRaw(otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
@@ -296,11 +313,7 @@ func TestSumRange(t *testing.T) {
 	}
 }
 func TestAverageRange(t *testing.T) {
-	tokens := []efp.Token{
-		{TType: "Function", TSubType: "Start", TValue: "AVERAGE"},
-		{TType: "Operand", TSubType: "Range", TValue: "A1:A3"},
-		{TType: "Function", TSubType: "Stop", TValue: ""},
-	}
+	c := &Cell{RawValue: "=AVERAGE(A1:A3)"}
 
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "10"},
@@ -309,7 +322,7 @@ func TestAverageRange(t *testing.T) {
 		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "40"},
 	}
 
-	result, err := averageRange(tokens, otherCells)
+	result, err := c.ComputeValueFromRaw(otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
@@ -320,11 +333,7 @@ func TestAverageRange(t *testing.T) {
 	}
 }
 func TestMinRange(t *testing.T) {
-	tokens := []efp.Token{
-		{TType: "Function", TSubType: "Start", TValue: "MAX"},
-		{TType: "Operand", TSubType: "Range", TValue: "A1:A4"},
-		{TType: "Function", TSubType: "Stop", TValue: ""},
-	}
+	c := &Cell{RawValue: "=MIN(A1:A4)"}
 
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "10"},
@@ -334,7 +343,7 @@ func TestMinRange(t *testing.T) {
 		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "40"},
 	}
 
-	result, err := minRange(tokens, otherCells)
+	result, err := c.ComputeValueFromRaw(otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
@@ -346,11 +355,7 @@ func TestMinRange(t *testing.T) {
 }
 
 func TestMaxRange(t *testing.T) {
-	tokens := []efp.Token{
-		{TType: "Function", TSubType: "Start", TValue: "MAX"},
-		{TType: "Operand", TSubType: "Range", TValue: "A1:A4"},
-		{TType: "Function", TSubType: "Stop", TValue: ""},
-	}
+	c := &Cell{RawValue: "=MAX(A1:A4)"}
 
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "10"},
@@ -360,7 +365,7 @@ func TestMaxRange(t *testing.T) {
 		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "40"},
 	}
 
-	result, err := maxRange(tokens, otherCells)
+	result, err := c.ComputeValueFromRaw(otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
@@ -372,11 +377,7 @@ func TestMaxRange(t *testing.T) {
 }
 
 func TestCountRange(t *testing.T) {
-	tokens := []efp.Token{
-		{TType: "Function", TSubType: "Start", TValue: "COUNT"},
-		{TType: "Operand", TSubType: "Range", TValue: "A1:A3"},
-		{TType: "Function", TSubType: "Stop", TValue: ""},
-	}
+	c := &Cell{RawValue: "=COUNT(A1:A3)"}
 
 	otherCells := []Cell{
 		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "10"},
@@ -385,7 +386,7 @@ func TestCountRange(t *testing.T) {
 		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "40"},
 	}
 
-	result, err := countRange(tokens, otherCells)
+	result, err := c.ComputeValueFromRaw(otherCells)
 	if err != nil {
 		t.Errorf("Error occurred: %s", err.Error())
 	}
@@ -439,6 +440,106 @@ func TestCheckIfCellInRange(t *testing.T) {
 	}
 }
 
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		tvalue   string
+		expected cellRange
+	}{
+		{"A1:B3", cellRange{startColumn: 0, startRow: 0, endColumn: 1, endRow: 2}},
+		{"B3:A1", cellRange{startColumn: 0, startRow: 0, endColumn: 1, endRow: 2}},
+		{"B:B", cellRange{startColumn: 1, startRow: 0, endColumn: 1, endRow: openRangeEnd}},
+		{"$B:C", cellRange{startColumn: 1, startRow: 0, endColumn: 2, endRow: openRangeEnd}},
+		{"3:3", cellRange{startColumn: 0, startRow: 2, endColumn: openRangeEnd, endRow: 2}},
+		{"B2:B", cellRange{startColumn: 1, startRow: 1, endColumn: 1, endRow: openRangeEnd}},
+		{"B2:4", cellRange{startColumn: 1, startRow: 1, endColumn: openRangeEnd, endRow: 3}},
+	}
+	for _, test := range tests {
+		t.Run(test.tvalue, func(t *testing.T) {
+			bounds, err := parseRange(test.tvalue)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, bounds)
+		})
+	}
+
+	for _, tvalue := range []string{"A:3", "B:B2", "2:B3", "0:1", "A1", "A1:B2:C3"} {
+		_, err := parseRange(tvalue)
+		assert.Error(t, err, tvalue)
+	}
+
+	t.Run("open ends are clamped to the last row and column", func(t *testing.T) {
+		bounds, _ := parseRange("B2:B")
+		assert.Equal(t, cellRange{startColumn: 1, startRow: 1, endColumn: 1, endRow: 9}, bounds.clamp(4, 9))
+		assert.Equal(t, cellRange{startColumn: 1, startRow: 1, endColumn: 1, endRow: 1}, bounds.clamp(4, 0))
+	})
+}
+
+func TestWholeColumnAndRowRanges(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "Amount", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "10", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 2, ComputedValue: "20", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "5", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 3, RowIndex: 1, ComputedValue: "1", ComputedType: ValueTypeNumber},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=SUM(B:B)", "30"},
+		{"=SUM(B2:B)", "30"},
+		{"=COUNT(2:2)", "3"},
+		{"=SUM(B2:2)", "11"},
+		{"=SUM(A:B)", "35"},
+		{"=SUM(E:E)", "0"},
+		{"=SUM(B9:B)", "0"},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+			result, err := c.ComputeValueFromRaw(otherCells)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("ranges end at the size of the spreadsheet", func(t *testing.T) {
+		c := &Cell{RawValue: "=COUNTIF(B:B, \"\")"}
+		assert.Equal(t, "7", c.computeValue(otherCells, Spreadsheet{RowCount: 10, ColumnCount: 5}).String())
+	})
+
+	t.Run("ranges only hold the cells written in them", func(t *testing.T) {
+		spreadsheet := Spreadsheet{RowCount: 1000000, ColumnCount: 16384}
+		e := &evaluator{cell: &Cell{}, cells: otherCells, spreadsheet: spreadsheet}
+		value, err := e.evaluateRange("A:B", "")
+		assert.NoError(t, err)
+		rowCount, columnCount := value.size()
+		assert.Equal(t, 1000000, rowCount)
+		assert.Equal(t, 2, columnCount)
+		assert.Nil(t, value.Array)
+		assert.Equal(t, []Value{StringValue("Amount"), NumberValue(5), NumberValue(10), NumberValue(20)}, value.writtenValues())
+
+		for formula, expected := range map[string]string{
+			"=SUM(A:B)":                      "35",
+			"=COUNTIF(B:B, \">15\")":         "1",
+			"=SUMIFS(B:B, A:A, 5)":           "10",
+			"=SUMIF(B:B, \"<>Amount\", A:A)": "5",
+			"=VLOOKUP(5, A:B, 2, FALSE)":     "10",
+			"=HLOOKUP(\"Amount\", B:B, 3)":   "20",
+			"=INDEX(A:B, 3, 2)":              "20",
+			"=SUM(INDEX(A:B, 0, 2))":         "30",
+			"=MATCH(20, B:B, 0)":             "3",
+			"=XLOOKUP(10, B:B, A:A)":         "5",
+			"=CONCAT(B:B)":                   "Amount1020",
+			"=TEXTJOIN(\",\", TRUE, A:B)":    "Amount,5,10,20",
+			"=TEXTJOIN(\",\", FALSE, A:A)":   "#VALUE!",
+		} {
+			c := &Cell{RawValue: formula}
+			assert.Equal(t, expected, c.computeValue(otherCells, spreadsheet).String(), formula)
+		}
+	})
+}
+
 func TestFindDependentCells(t *testing.T) {
 	c := Cell{
 		ColumnIndex: 0,
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ConflictPolicy decides what happens to a cell update made against a version of the cell that is no longer
+// the latest.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyLastWriterWins saves every update, the expected version is not checked.
+	ConflictPolicyLastWriterWins ConflictPolicy = "LAST_WRITER_WINS"
+	// ConflictPolicyRejectOnConflict rejects updates whose expected version is not the current version of the cell.
+	ConflictPolicyRejectOnConflict ConflictPolicy = "REJECT_ON_CONFLICT"
+)
+
+func (e ConflictPolicy) IsValid() bool {
+	switch e {
+	case ConflictPolicyLastWriterWins, ConflictPolicyRejectOnConflict:
+		return true
+	}
+	return false
+}
+
+func (e ConflictPolicy) String() string {
+	return string(e)
+}
+
+func (e *ConflictPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConflictPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConflictPolicy", str)
+	}
+	return nil
+}
+
+func (e ConflictPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// ConflictError rejects an update made against an earlier version of a cell, Cell is the cell as it is now.
+// A position that was never set has no current cell and version 0.
+type ConflictError struct {
+	Cell            Cell
+	ExpectedVersion uint64
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("cell %s was changed at version %d, expected version %d", keyOf(&e.Cell).code(), e.Cell.Version, e.ExpectedVersion)
+}
+
+// parseExpectedVersion reads the version a client expects a cell to be at, nil when it did not give one.
+func parseExpectedVersion(expectedVersion *string) (*uint64, error) {
+	if expectedVersion == nil {
+		return nil, nil
+	}
+	version, err := strconv.ParseUint(*expectedVersion, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expected version %s", *expectedVersion)
+	}
+	return &version, nil
+}
+
+// checkConflict returns a ConflictError when the latest of cells at the position of c is not at expectedVersion
+// and the sheet rejects conflicting updates. Without an expected version any update is accepted.
+func checkConflict(spreadsheet Spreadsheet, cells []Cell, c Cell, expectedVersion *uint64) error {
+	if expectedVersion == nil {
+		return nil
+	}
+	sheet, ok := spreadsheet.sheetByID(c.SheetID)
+	if !ok || sheet.ConflictPolicy == ConflictPolicyLastWriterWins {
+		return nil
+	}
+	current := Cell{SpreadsheetID: c.SpreadsheetID, SheetID: c.SheetID, ColumnIndex: c.ColumnIndex, RowIndex: c.RowIndex}
+	for _, cell := range cells {
+		if keyOf(&cell) == keyOf(&c) {
+			current = cell
+			break
+		}
+	}
+	if current.Version != *expectedVersion {
+		return &ConflictError{Cell: current, ExpectedVersion: *expectedVersion}
+	}
+	return nil
+}
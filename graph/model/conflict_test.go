@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestCheckConflict(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+	spreadsheet.Sheets[1].ConflictPolicy = ConflictPolicyLastWriterWins
+	cells := []Cell{
+		{Model: gorm.Model{ID: 4}, SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "2", Version: 7},
+		{Model: gorm.Model{ID: 5}, SheetID: "2", ColumnIndex: 1, RowIndex: 1, RawValue: "3", Version: 7},
+	}
+	version := func(v uint64) *uint64 { return &v }
+	b2 := Cell{SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "4"}
+
+	assert.NoError(t, checkConflict(spreadsheet, cells, b2, nil))
+	assert.NoError(t, checkConflict(spreadsheet, cells, b2, version(7)))
+
+	t.Run("rejects updates made against an earlier version with the current cell", func(t *testing.T) {
+		err := checkConflict(spreadsheet, cells, b2, version(5))
+		var conflict *ConflictError
+		assert.ErrorAs(t, err, &conflict)
+		assert.Equal(t, uint(4), conflict.Cell.ID)
+		assert.Equal(t, "2", conflict.Cell.RawValue)
+		assert.Equal(t, uint64(5), conflict.ExpectedVersion)
+		assert.Equal(t, "cell B2 was changed at version 7, expected version 5", err.Error())
+	})
+
+	t.Run("positions that were never set are at version 0", func(t *testing.T) {
+		c3 := Cell{SheetID: "1", ColumnIndex: 2, RowIndex: 2}
+		assert.NoError(t, checkConflict(spreadsheet, cells, c3, version(0)))
+		var conflict *ConflictError
+		assert.ErrorAs(t, checkConflict(spreadsheet, []Cell{{SheetID: "1", ColumnIndex: 2, RowIndex: 2, Version: 9}}, c3, version(0)), &conflict)
+	})
+
+	t.Run("sheets where the last writer wins accept every update", func(t *testing.T) {
+		assert.NoError(t, checkConflict(spreadsheet, cells, Cell{SheetID: "2", ColumnIndex: 1, RowIndex: 1}, version(5)))
+	})
+}
+
+func TestParseExpectedVersion(t *testing.T) {
+	version, err := parseExpectedVersion(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, version)
+
+	given := "1690000000000"
+	version, err = parseExpectedVersion(&given)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1690000000000), *version)
+
+	invalid := "yesterday"
+	_, err = parseExpectedVersion(&invalid)
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+// criteria is a condition such as ">10", "<>foo" or "app*" as used by SUMIF, COUNTIFS and friends.
+type criteria struct {
+	operator string
+	value    Value
+	// pattern matches text for = and <> criteria, * and ? are wildcards and ~ escapes them
+	pattern *regexp.Regexp
+}
+
+var criteriaOperators = []string{">=", "<=", "<>", ">", "<", "="}
+
+// parseCriteria reads a criteria argument. Numbers and booleans match equal values, text may start
+// with a comparison operator and otherwise matches case insensitively with wildcards.
+func parseCriteria(v Value) criteria {
+	if v.Type != ValueTypeString {
+		return criteria{operator: "=", value: v}
+	}
+	c := criteria{operator: "="}
+	text := v.Text
+	for _, operator := range criteriaOperators {
+		if strings.HasPrefix(text, operator) {
+			c.operator = operator
+			text = text[len(operator):]
+			break
+		}
+	}
+	c.value = parseValue(text)
+	if c.value.Type == ValueTypeString {
+		c.pattern = wildcardPattern(text)
+	}
+	return c
+}
+
+func wildcardPattern(text string) *regexp.Regexp {
+	var pattern strings.Builder
+	pattern.WriteString("(?is)^")
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '~' && i+1 < len(runes):
+			i++
+			pattern.WriteString(regexp.QuoteMeta(string(runes[i])))
+		case runes[i] == '*':
+			pattern.WriteString(".*")
+		case runes[i] == '?':
+			pattern.WriteString(".")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(runes[i])))
+		}
+	}
+	pattern.WriteString("$")
+	return regexp.MustCompile(pattern.String())
+}
+
+func (c criteria) matches(v Value) bool {
+	if v.Type == ValueTypeError {
+		return false
+	}
+	switch c.operator {
+	case "=":
+		return c.equals(v)
+	case "<>":
+		return !c.equals(v)
+	}
+	// ordering criteria only match values of the same kind, ">5" never matches text
+	if v.Type == ValueTypeEmpty || c.value.Type == ValueTypeEmpty || comparisonRank(v) != comparisonRank(c.value) {
+		return false
+	}
+	comparison := compareValues(v, c.value)
+	switch c.operator {
+	case "<":
+		return comparison < 0
+	case "<=":
+		return comparison <= 0
+	case ">":
+		return comparison > 0
+	case ">=":
+		return comparison >= 0
+	}
+	return false
+}
+
+func (c criteria) equals(v Value) bool {
+	if c.value.Type == ValueTypeEmpty {
+		return v.Type == ValueTypeEmpty || (v.Type == ValueTypeString && v.Text == "")
+	}
+	switch {
+	case v.Type == ValueTypeEmpty:
+		return false
+	case c.pattern != nil:
+		return v.Type == ValueTypeString && c.pattern.MatchString(v.Text)
+	case c.value.isNumeric():
+		if v.isNumeric() {
+			return v.Number == c.value.Number
+		}
+		// like Excel, text that reads as the number matches as well
+		number, ok := parseNumber(v.Text)
+		return v.Type == ValueTypeString && ok && number == c.value.Number
+	}
+	return comparisonRank(v) == comparisonRank(c.value) && compareValues(v, c.value) == 0
+}
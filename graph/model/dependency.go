@@ -0,0 +1,387 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// cellKey identifies a cell position within a spreadsheet, sheet is the ID of the sheet the cell is in.
+type cellKey struct {
+	sheet  string
+	column int
+	row    int
+}
+
+func keyOf(c *Cell) cellKey {
+	return cellKey{sheet: c.SheetID, column: c.ColumnIndex, row: c.RowIndex}
+}
+
+// code returns the A1 style code of the cell.
+func (k cellKey) code() string {
+	return columnCodeFromColumnIndex(k.column) + strconv.Itoa(k.row+1)
+}
+
+// formulaReferences returns every cell and range reference whose value a formula reads.
+func formulaReferences(node formulaNode) []string {
+	switch n := node.(type) {
+	case *referenceNode:
+		return []string{n.reference}
+	case *unaryNode:
+		return formulaReferences(n.operand)
+	case *binaryNode:
+		return append(formulaReferences(n.left), formulaReferences(n.right)...)
+	case *functionNode:
+		var references []string
+		for _, argument := range n.arguments {
+			references = append(references, formulaReferences(argument)...)
+		}
+		return references
+	}
+	return nil
+}
+
+// referenceBounds resolves a reference to the cells it covers, a single cell is a one cell range. The sheet
+// a reference such as Sheet2!A1 names is left out, see splitSheetReference.
+func referenceBounds(reference string) (cellRange, bool) {
+	_, reference = splitSheetReference(reference)
+	if strings.Contains(reference, ":") {
+		bounds, err := parseRange(reference)
+		return bounds, err == nil
+	}
+	columnIndex, rowIndex, err := columnAndRowIndexFromCode(reference)
+	if err != nil {
+		return cellRange{}, false
+	}
+	return cellRange{startColumn: columnIndex, startRow: rowIndex, endColumn: columnIndex, endRow: rowIndex}, true
+}
+
+type rangeDependent struct {
+	bounds    cellRange
+	dependent cellKey
+}
+
+// dependencyGraph indexes which cells every formula in a spreadsheet reads (its precedents) and, in
+// reverse, which formulas read a given cell (its dependents).
+type dependencyGraph struct {
+	precedents map[cellKey][]cellRange
+	// dependents indexes formula cells by the single cells they reference
+	dependents map[cellKey][]cellKey
+	// rangeDependents holds formula cells that reference multi-cell ranges, they are matched by
+	// containment so cells written into the range later are picked up as precedents as well
+	rangeDependents []rangeDependent
+	dependentsCache map[cellKey][]cellKey
+}
+
+// buildDependencyGraph indexes the formulas of cells. Names and references to other sheets are resolved through
+// the spreadsheet, so a formula using a name depends on the cells the name stands for, and references to
+// sheets or names that do not exist are left out.
+func buildDependencyGraph(cells []Cell, spreadsheet Spreadsheet) *dependencyGraph {
+	g := &dependencyGraph{
+		precedents:      make(map[cellKey][]cellRange),
+		dependents:      make(map[cellKey][]cellKey),
+		dependentsCache: make(map[cellKey][]cellKey),
+	}
+	for i := range cells {
+		g.add(&cells[i], spreadsheet)
+	}
+	return g
+}
+
+func (g *dependencyGraph) add(c *Cell, spreadsheet Spreadsheet) {
+	if len(c.RawValue) == 0 || c.RawValue[0] != '=' {
+		return
+	}
+	formula, err := c.parseFormula()
+	if err != nil {
+		return
+	}
+	key := keyOf(c)
+	for _, reference := range formulaReferences(formula) {
+		if target, ok := spreadsheet.resolveName(reference); ok {
+			reference = target
+		}
+		bounds, ok := referenceBounds(reference)
+		if !ok {
+			continue
+		}
+		bounds.sheet = c.SheetID
+		if name, _ := splitSheetReference(reference); name != "" {
+			sheet, found := spreadsheet.sheetByName(name)
+			if !found {
+				continue
+			}
+			bounds.sheet = sheet.id()
+		}
+		g.precedents[key] = append(g.precedents[key], bounds)
+		if bounds.startColumn == bounds.endColumn && bounds.startRow == bounds.endRow {
+			precedent := cellKey{sheet: bounds.sheet, column: bounds.startColumn, row: bounds.startRow}
+			g.dependents[precedent] = append(g.dependents[precedent], key)
+		} else {
+			g.rangeDependents = append(g.rangeDependents, rangeDependent{bounds: bounds, dependent: key})
+		}
+	}
+}
+
+// directDependents returns the formula cells that read key, sorted so recalculation is deterministic.
+func (g *dependencyGraph) directDependents(key cellKey) []cellKey {
+	if dependents, ok := g.dependentsCache[key]; ok {
+		return dependents
+	}
+	seen := make(map[cellKey]bool)
+	var dependents []cellKey
+	for _, dependent := range g.dependents[key] {
+		if !seen[dependent] {
+			seen[dependent] = true
+			dependents = append(dependents, dependent)
+		}
+	}
+	position := Cell{SheetID: key.sheet, ColumnIndex: key.column, RowIndex: key.row}
+	for _, rd := range g.rangeDependents {
+		if !seen[rd.dependent] && rd.bounds.contains(&position) {
+			seen[rd.dependent] = true
+			dependents = append(dependents, rd.dependent)
+		}
+	}
+	sortCellKeys(dependents)
+	g.dependentsCache[key] = dependents
+	return dependents
+}
+
+// recalculationStep is computed as a unit, either a single cell or every cell of a circular reference.
+type recalculationStep struct {
+	cells  []cellKey
+	cyclic bool
+}
+
+// recalculationOrder returns the changed cells and every cell that transitively depends on them, grouped
+// into steps in topological order so that each step comes after all of its precedents.
+func (g *dependencyGraph) recalculationOrder(changed []cellKey) []recalculationStep {
+	affected := make(map[cellKey]bool)
+	var keys []cellKey
+	queue := append([]cellKey{}, changed...)
+	for _, key := range changed {
+		if !affected[key] {
+			affected[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		for _, dependent := range g.directDependents(key) {
+			if !affected[dependent] {
+				affected[dependent] = true
+				keys = append(keys, dependent)
+				queue = append(queue, dependent)
+			}
+		}
+	}
+	sortCellKeys(keys)
+
+	components := g.stronglyConnectedComponents(keys, affected)
+	sort.Slice(components, func(i, j int) bool {
+		return cellKeyLess(components[i][0], components[j][0])
+	})
+	componentOf := make(map[cellKey]int)
+	for i, component := range components {
+		for _, key := range component {
+			componentOf[key] = i
+		}
+	}
+
+	// Kahn's algorithm over the components, a component is ready once all of its precedents are computed
+	inDegree := make([]int, len(components))
+	for i, component := range components {
+		for _, key := range component {
+			for _, dependent := range g.directDependents(key) {
+				if affected[dependent] && componentOf[dependent] != i {
+					inDegree[componentOf[dependent]]++
+				}
+			}
+		}
+	}
+	var ready []int
+	for i := range components {
+		if inDegree[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	order := make([]recalculationStep, 0, len(components))
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		order = append(order, recalculationStep{cells: components[i], cyclic: g.isCyclic(components[i])})
+		for _, key := range components[i] {
+			for _, dependent := range g.directDependents(key) {
+				if !affected[dependent] || componentOf[dependent] == i {
+					continue
+				}
+				inDegree[componentOf[dependent]]--
+				if inDegree[componentOf[dependent]] == 0 {
+					ready = append(ready, componentOf[dependent])
+				}
+			}
+		}
+	}
+	return order
+}
+
+// stronglyConnectedComponents groups keys using Tarjan's algorithm, cells that reference each other in a
+// circle, directly or through other cells, end up in the same component. Only edges within keys are followed.
+func (g *dependencyGraph) stronglyConnectedComponents(keys []cellKey, within map[cellKey]bool) [][]cellKey {
+	index := make(map[cellKey]int)
+	lowLink := make(map[cellKey]int)
+	onStack := make(map[cellKey]bool)
+	var stack []cellKey
+	var components [][]cellKey
+
+	var visit func(key cellKey)
+	visit = func(key cellKey) {
+		index[key] = len(index)
+		lowLink[key] = index[key]
+		stack = append(stack, key)
+		onStack[key] = true
+		for _, dependent := range g.directDependents(key) {
+			if !within[dependent] {
+				continue
+			}
+			if _, visited := index[dependent]; !visited {
+				visit(dependent)
+				if lowLink[dependent] < lowLink[key] {
+					lowLink[key] = lowLink[dependent]
+				}
+			} else if onStack[dependent] && index[dependent] < lowLink[key] {
+				lowLink[key] = index[dependent]
+			}
+		}
+		if lowLink[key] != index[key] {
+			return
+		}
+		var component []cellKey
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == key {
+				break
+			}
+		}
+		sortCellKeys(component)
+		components = append(components, component)
+	}
+
+	for _, key := range keys {
+		if _, visited := index[key]; !visited {
+			visit(key)
+		}
+	}
+	return components
+}
+
+// isCyclic reports whether a component is a circular reference, a single cell only is when it references itself.
+func (g *dependencyGraph) isCyclic(component []cellKey) bool {
+	if len(component) > 1 {
+		return true
+	}
+	for _, dependent := range g.directDependents(component[0]) {
+		if dependent == component[0] {
+			return true
+		}
+	}
+	return false
+}
+
+func cellKeyLess(a cellKey, b cellKey) bool {
+	if a.sheet != b.sheet {
+		return a.sheet < b.sheet
+	}
+	if a.row != b.row {
+		return a.row < b.row
+	}
+	return a.column < b.column
+}
+
+func sortCellKeys(keys []cellKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		return cellKeyLess(keys[i], keys[j])
+	})
+}
+
+// recalculate computes the changed cells and then every cell that transitively depends on them, each
+// exactly once and in topological order. Cells in a circular reference evaluate to #CYCLE!, unless the
+// spreadsheet enables iterative calculation, and are returned in cycles. cells is updated in place and
+// the recomputed cells are returned in the order they were computed.
+func recalculate(cells []Cell, changed []cellKey, spreadsheet Spreadsheet) (recomputed []*Cell, cycles [][]cellKey) {
+	index := make(map[cellKey]*Cell, len(cells))
+	for i := range cells {
+		index[keyOf(&cells[i])] = &cells[i]
+	}
+
+	for _, step := range buildDependencyGraph(cells, spreadsheet).recalculationOrder(changed) {
+		var stepCells []*Cell
+		for _, key := range step.cells {
+			if cell, ok := index[key]; ok {
+				stepCells = append(stepCells, cell)
+			}
+		}
+		switch {
+		case !step.cyclic:
+			for _, cell := range stepCells {
+				cell.setComputedValue(cells, spreadsheet)
+			}
+		case spreadsheet.IterativeCalculation:
+			iterate(stepCells, cells, spreadsheet)
+		default:
+			codes := make([]string, len(step.cells))
+			for i, key := range step.cells {
+				codes[i] = key.code()
+			}
+			for _, cell := range stepCells {
+				cell.setValue(ErrorValue(ErrorCodeCycle, "circular reference between "+strings.Join(codes, ", ")))
+			}
+		}
+		if step.cyclic {
+			cycles = append(cycles, step.cells)
+		}
+		recomputed = append(recomputed, stepCells...)
+	}
+	return recomputed, cycles
+}
+
+// iterate computes a circular reference by evaluating its cells over and over, each pass starting from the
+// values of the previous one, until no value moves by more than the convergence threshold or the spreadsheet's
+// MaxIterations is reached.
+func iterate(cycle []*Cell, cells []Cell, spreadsheet Spreadsheet) {
+	for _, cell := range cycle {
+		// start over from empty rather than propagating the error of an earlier non-iterative calculation
+		if value := cell.TypedValue(); value.Type == ValueTypeError && value.Error.Code == ErrorCodeCycle {
+			cell.setValue(EmptyValue())
+		}
+	}
+	for i := 0; i < spreadsheet.MaxIterations; i++ {
+		change := 0.0
+		for _, cell := range cycle {
+			previous := cell.TypedValue()
+			cell.setComputedValue(cells, spreadsheet)
+			change = math.Max(change, valueChange(previous, cell.TypedValue()))
+		}
+		if change <= spreadsheet.ConvergenceThreshold {
+			return
+		}
+	}
+}
+
+// valueChange is how far a value moved between two iterations, any change that is not numeric is infinite.
+func valueChange(previous Value, current Value) float64 {
+	if previous.isNumeric() && current.isNumeric() {
+		return math.Abs(current.Number - previous.Number)
+	}
+	if previous.Type != current.Type || previous.String() != current.String() {
+		return math.Inf(1)
+	}
+	return 0
+}
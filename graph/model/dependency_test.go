@@ -0,0 +1,164 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func stepCells(steps []recalculationStep) []cellKey {
+	var keys []cellKey
+	for _, step := range steps {
+		keys = append(keys, step.cells...)
+	}
+	return keys
+}
+
+func TestRecalculationOrder(t *testing.T) {
+	t.Run("chains are ordered after their precedents", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "1"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=B1*2"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1+1"},
+			{ColumnIndex: 3, RowIndex: 0, RawValue: "=C1+B1"},
+		}
+
+		order := buildDependencyGraph(cells, Spreadsheet{}).recalculationOrder([]cellKey{{column: 0, row: 0}})
+
+		assert.Equal(t, []cellKey{{column: 0, row: 0}, {column: 1, row: 0}, {column: 2, row: 0}, {column: 3, row: 0}}, stepCells(order))
+	})
+
+	t.Run("range references are dependents of every cell in the range", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 5, RawValue: "=SUM(A1:A3)"},
+			{ColumnIndex: 1, RowIndex: 5, RawValue: "=A6/2"},
+		}
+
+		order := buildDependencyGraph(cells, Spreadsheet{}).recalculationOrder([]cellKey{{column: 0, row: 1}})
+
+		assert.Equal(t, []cellKey{{column: 0, row: 1}, {column: 0, row: 5}, {column: 1, row: 5}}, stepCells(order))
+	})
+
+	t.Run("unrelated cells are not recalculated", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1"},
+			{ColumnIndex: 1, RowIndex: 1, RawValue: "=A2"},
+		}
+
+		order := buildDependencyGraph(cells, Spreadsheet{}).recalculationOrder([]cellKey{{column: 0, row: 1}})
+
+		assert.Equal(t, []cellKey{{column: 0, row: 1}, {column: 1, row: 1}}, stepCells(order))
+	})
+
+	t.Run("circular references are grouped into one step", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "=C1"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=B1"},
+			{ColumnIndex: 3, RowIndex: 0, RawValue: "=C1*2"},
+			{ColumnIndex: 0, RowIndex: 1, RawValue: "=A2+1"},
+		}
+		graph := buildDependencyGraph(cells, Spreadsheet{})
+
+		order := graph.recalculationOrder([]cellKey{{column: 1, row: 0}})
+
+		assert.Equal(t, []recalculationStep{
+			{cells: []cellKey{{column: 0, row: 0}, {column: 1, row: 0}, {column: 2, row: 0}}, cyclic: true},
+			{cells: []cellKey{{column: 3, row: 0}}},
+		}, order)
+		assert.Equal(t, []recalculationStep{
+			{cells: []cellKey{{column: 0, row: 1}}, cyclic: true},
+		}, graph.recalculationOrder([]cellKey{{column: 0, row: 1}}))
+	})
+}
+
+func TestRecalculate(t *testing.T) {
+	t.Run("diamond dependencies are computed once with up to date precedents", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "5"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1*2", ComputedValue: "2"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=A1+1", ComputedValue: "2"},
+			{ColumnIndex: 3, RowIndex: 0, RawValue: "=B1+C1", ComputedValue: "4"},
+			{ColumnIndex: 4, RowIndex: 0, RawValue: "=D1&\"!\"", ComputedValue: "4!"},
+		}
+
+		recomputed, cycles := recalculate(cells, []cellKey{{column: 0, row: 0}}, Spreadsheet{})
+
+		var computedValues []string
+		for _, cell := range recomputed {
+			computedValues = append(computedValues, cell.ComputedValue)
+		}
+		assert.Equal(t, []string{"5", "10", "6", "16", "16!"}, computedValues)
+		assert.Equal(t, "16!", cells[4].ComputedValue)
+		assert.Empty(t, cycles)
+	})
+
+	t.Run("a cell written into a referenced range updates the formula", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "1", ComputedValue: "1"},
+			{ColumnIndex: 0, RowIndex: 3, RawValue: "=SUM(A1:A3)", ComputedValue: "1"},
+		}
+		cells = withCell(cells, Cell{ColumnIndex: 0, RowIndex: 2, RawValue: "2"})
+
+		recomputed, _ := recalculate(cells, []cellKey{{column: 0, row: 2}}, Spreadsheet{})
+
+		assert.Equal(t, 2, len(recomputed))
+		assert.Equal(t, "3", cells[1].ComputedValue)
+	})
+
+	t.Run("a cell appended to a whole column updates the formula", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "1", ComputedValue: "1"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=SUM(A:A)", ComputedValue: "1"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=COUNT(A2:A)", ComputedValue: "0"},
+		}
+		cells = withCell(cells, Cell{ColumnIndex: 0, RowIndex: 41, RawValue: "2"})
+
+		recomputed, _ := recalculate(cells, []cellKey{{column: 0, row: 41}}, Spreadsheet{RowCount: 100, ColumnCount: 3})
+
+		assert.Equal(t, 3, len(recomputed))
+		assert.Equal(t, "3", cells[1].ComputedValue)
+		assert.Equal(t, "1", cells[2].ComputedValue)
+	})
+
+	t.Run("circular references evaluate to #CYCLE!", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "=B1"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=A1+1"},
+		}
+
+		_, cycles := recalculate(cells, []cellKey{{column: 0, row: 0}}, Spreadsheet{})
+
+		assert.Equal(t, [][]cellKey{{{column: 0, row: 0}, {column: 1, row: 0}}}, cycles)
+		for _, cell := range cells {
+			assert.Equal(t, "#CYCLE!", cell.ComputedValue)
+			assert.Equal(t, ValueTypeError, cell.ComputedType)
+		}
+		assert.Equal(t, "circular reference between A1, B1", cells[0].ErrorMessage)
+	})
+
+	t.Run("iterative calculation converges circular references", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "=B1/2+1", ComputedValue: "#CYCLE!", ComputedType: ValueTypeError},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=A1", ComputedValue: "#CYCLE!", ComputedType: ValueTypeError},
+		}
+		spreadsheet := Spreadsheet{IterativeCalculation: true, MaxIterations: DefaultMaxIterations, ConvergenceThreshold: DefaultConvergenceThreshold}
+
+		_, cycles := recalculate(cells, []cellKey{{column: 0, row: 0}}, spreadsheet)
+
+		assert.Equal(t, 1, len(cycles))
+		assert.Equal(t, ValueTypeNumber, cells[0].ComputedType)
+		assert.InDelta(t, 2, cells[0].TypedValue().Number, DefaultConvergenceThreshold*2)
+	})
+
+	t.Run("iterative calculation stops after max iterations", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "=A1+1"},
+		}
+		spreadsheet := Spreadsheet{IterativeCalculation: true, MaxIterations: 5, ConvergenceThreshold: DefaultConvergenceThreshold}
+
+		recalculate(cells, []cellKey{{column: 0, row: 0}}, spreadsheet)
+
+		assert.Equal(t, "5", cells[0].ComputedValue)
+	})
+}
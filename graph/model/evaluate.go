@@ -0,0 +1,316 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// evaluator computes the value of a formula against the other cells of a spreadsheet.
+// Spreadsheet errors such as #DIV/0! are returned as a *FormulaError so they propagate through the expression.
+type evaluator struct {
+	// cell is the cell whose formula is evaluated
+	cell  *Cell
+	cells []Cell
+	// spreadsheet bounds whole column and row ranges and holds the sheets and names references are resolved through
+	spreadsheet Spreadsheet
+}
+
+func (e *evaluator) evaluate(node formulaNode) (Value, error) {
+	switch n := node.(type) {
+	case *numberNode:
+		return NumberValue(n.value), nil
+	case *textNode:
+		return StringValue(n.value), nil
+	case *booleanNode:
+		return BooleanValue(n.value), nil
+	case *emptyNode:
+		return EmptyValue(), nil
+	case *errorNode:
+		return ErrorValue(n.code, defaultErrorMessages[n.code]), nil
+	case *referenceNode:
+		return e.evaluateReference(n.reference)
+	case *unaryNode:
+		return e.evaluateUnary(n)
+	case *binaryNode:
+		return e.evaluateBinary(n)
+	case *functionNode:
+		return e.evaluateFunction(n)
+	}
+	return Value{}, newFormulaError(ErrorCodeParse, "unsupported expression %T", node)
+}
+
+func (e *evaluator) evaluateReference(reference string) (Value, error) {
+	if target, ok := e.spreadsheet.resolveName(reference); ok {
+		if target == string(ErrorCodeRef) {
+			return Value{}, newFormulaError(ErrorCodeRef, "name %s refers to deleted cells", reference)
+		}
+		reference = target
+	}
+	name, reference := splitSheetReference(reference)
+	sheet, err := e.resolveSheet(name)
+	if err != nil {
+		return Value{}, err
+	}
+	if strings.Contains(reference, ":") {
+		return e.evaluateRange(reference, sheet)
+	}
+	columnIndex, rowIndex, err := columnAndRowIndexFromCode(reference)
+	if err != nil {
+		return Value{}, newFormulaError(ErrorCodeName, "unknown name %s", reference)
+	}
+	for i := range e.cells {
+		if e.cells[i].SheetID == sheet && e.cells[i].ColumnIndex == columnIndex && e.cells[i].RowIndex == rowIndex {
+			return e.cells[i].TypedValue(), nil
+		}
+	}
+	// cells that have never been written are blank
+	return EmptyValue(), nil
+}
+
+// resolveSheet returns the ID of the sheet a reference is in, the sheet of the evaluated cell when it names none.
+func (e *evaluator) resolveSheet(name string) (string, error) {
+	if name == "" {
+		if e.cell == nil {
+			return "", nil
+		}
+		return e.cell.SheetID, nil
+	}
+	sheet, ok := e.spreadsheet.sheetByName(name)
+	if !ok {
+		return "", newFormulaError(ErrorCodeRef, "unknown sheet %s", name)
+	}
+	return sheet.id(), nil
+}
+
+// evaluateRange collects the written cells of a range within a sheet, cells that have never been written are empty.
+func (e *evaluator) evaluateRange(reference string, sheet string) (Value, error) {
+	bounds, err := parseRange(reference)
+	if err != nil {
+		return Value{}, newFormulaError(ErrorCodeName, "%v", err)
+	}
+	bounds.sheet = sheet
+	bounds = e.clamp(bounds)
+	values := &rangeValues{rowCount: bounds.endRow - bounds.startRow + 1, columnCount: bounds.endColumn - bounds.startColumn + 1}
+	for i := range e.cells {
+		if bounds.contains(&e.cells[i]) {
+			values.cells = append(values.cells, rangeCell{row: e.cells[i].RowIndex - bounds.startRow, column: e.cells[i].ColumnIndex - bounds.startColumn, value: e.cells[i].TypedValue()})
+		}
+	}
+	sort.SliceStable(values.cells, func(i, j int) bool {
+		if values.cells[i].row != values.cells[j].row {
+			return values.cells[i].row < values.cells[j].row
+		}
+		return values.cells[i].column < values.cells[j].column
+	})
+	// a position listed twice keeps its last cell
+	cells := values.cells[:0]
+	for _, cell := range values.cells {
+		if n := len(cells); n > 0 && cells[n-1].row == cell.row && cells[n-1].column == cell.column {
+			cells[n-1] = cell
+			continue
+		}
+		cells = append(cells, cell)
+	}
+	values.cells = cells
+	return rangeValue(values), nil
+}
+
+// clamp bounds an open ended range to the size of its sheet, or of the spreadsheet for cells that are not in a
+// known sheet, or to the cells written so far when the size is unknown.
+func (e *evaluator) clamp(bounds cellRange) cellRange {
+	rowCount, columnCount := e.spreadsheet.RowCount, e.spreadsheet.ColumnCount
+	if sheet, ok := e.spreadsheet.sheetByID(bounds.sheet); ok {
+		rowCount, columnCount = sheet.RowCount, sheet.ColumnCount
+	}
+	lastColumn, lastRow := columnCount-1, rowCount-1
+	if rowCount == 0 || columnCount == 0 {
+		for i := range e.cells {
+			if e.cells[i].SheetID != bounds.sheet {
+				continue
+			}
+			if e.cells[i].ColumnIndex > lastColumn {
+				lastColumn = e.cells[i].ColumnIndex
+			}
+			if e.cells[i].RowIndex > lastRow {
+				lastRow = e.cells[i].RowIndex
+			}
+		}
+	}
+	return bounds.clamp(lastColumn, lastRow)
+}
+
+func (e *evaluator) evaluateUnary(n *unaryNode) (Value, error) {
+	operand, err := e.evaluate(n.operand)
+	if err != nil {
+		return Value{}, err
+	}
+	number, err := operand.toNumber()
+	if err != nil {
+		return Value{}, err
+	}
+	switch n.operator {
+	case "-":
+		return NumberValue(-number), nil
+	case "%":
+		return NumberValue(number / 100), nil
+	}
+	return Value{}, newFormulaError(ErrorCodeParse, "unsupported operator %q", n.operator)
+}
+
+func (e *evaluator) evaluateBinary(n *binaryNode) (Value, error) {
+	left, err := e.evaluate(n.left)
+	if err != nil {
+		return Value{}, err
+	}
+	right, err := e.evaluate(n.right)
+	if err != nil {
+		return Value{}, err
+	}
+
+	switch n.operator {
+	case "=", "<>", "<", "<=", ">", ">=":
+		return compareOperands(n.operator, left, right)
+	}
+
+	if n.operator == "&" {
+		leftText, err := left.toText()
+		if err != nil {
+			return Value{}, err
+		}
+		rightText, err := right.toText()
+		if err != nil {
+			return Value{}, err
+		}
+		return StringValue(leftText + rightText), nil
+	}
+
+	leftNumber, err := left.toNumber()
+	if err != nil {
+		return Value{}, err
+	}
+	rightNumber, err := right.toNumber()
+	if err != nil {
+		return Value{}, err
+	}
+	switch n.operator {
+	case "+":
+		// a date plus a number of days is a date again
+		if isDate(left) != isDate(right) {
+			return serialDateValue(leftNumber + rightNumber)
+		}
+		return finiteNumber(NumberValue(leftNumber + rightNumber))
+	case "-":
+		// the difference of two dates is a number of days, a date minus days is a date
+		if isDate(left) && !isDate(right) {
+			return serialDateValue(leftNumber - rightNumber)
+		}
+		return finiteNumber(NumberValue(leftNumber - rightNumber))
+	case "*":
+		return finiteNumber(NumberValue(leftNumber * rightNumber))
+	case "/":
+		if rightNumber == 0 {
+			return Value{}, newFormulaError(ErrorCodeDivisionByZero, "division by zero")
+		}
+		return finiteNumber(NumberValue(leftNumber / rightNumber))
+	case "^":
+		return finiteNumber(NumberValue(math.Pow(leftNumber, rightNumber)))
+	}
+	return Value{}, newFormulaError(ErrorCodeParse, "unsupported operator %q", n.operator)
+}
+
+// finiteNumber returns #NUM! in place of a number that is not finite, such as the result of =(-1)^0.5 or
+// =1E+308*10, like Excel does. Such numbers could not be read back from the cell as a number.
+func finiteNumber(value Value) (Value, error) {
+	if (value.Type == ValueTypeNumber || value.Type == ValueTypeDate) && (math.IsNaN(value.Number) || math.IsInf(value.Number, 0)) {
+		return Value{}, newFormulaError(ErrorCodeNum, "the result is not a finite number")
+	}
+	return value, nil
+}
+
+func (e *evaluator) evaluateFunction(n *functionNode) (Value, error) {
+	function, ok := LookupFunction(n.name)
+	if !ok {
+		return Value{}, newFormulaError(ErrorCodeName, "unknown function %s", n.name)
+	}
+	if err := function.checkArgumentCount(len(n.arguments)); err != nil {
+		return Value{}, err
+	}
+	value, err := function.Evaluate(&FunctionCall{Name: function.Name, evaluator: e, arguments: n.arguments})
+	if err != nil {
+		return Value{}, err
+	}
+	// aggregates such as =SUM(1E+308, 1E+308) overflow just like the operators
+	return finiteNumber(value)
+}
+
+// compareOperands applies a comparison operator such as <= to two operands.
+func compareOperands(operator string, left Value, right Value) (Value, error) {
+	left, err := left.scalar()
+	if err != nil {
+		return Value{}, err
+	}
+	right, err = right.scalar()
+	if err != nil {
+		return Value{}, err
+	}
+	comparison := compareValues(left, right)
+	switch operator {
+	case "=":
+		return BooleanValue(comparison == 0), nil
+	case "<>":
+		return BooleanValue(comparison != 0), nil
+	case "<":
+		return BooleanValue(comparison < 0), nil
+	case "<=":
+		return BooleanValue(comparison <= 0), nil
+	case ">":
+		return BooleanValue(comparison > 0), nil
+	case ">=":
+		return BooleanValue(comparison >= 0), nil
+	}
+	return Value{}, newFormulaError(ErrorCodeParse, "unsupported operator %q", operator)
+}
+
+// numberArguments collects the numbers an aggregate works on. Like Excel, text and booleans inside a
+// referenced range are skipped while values passed directly must be convertible to a number, errors anywhere propagate.
+func (e *evaluator) numberArguments(arguments []formulaNode) ([]float64, error) {
+	var numbers []float64
+	for _, argument := range arguments {
+		value, err := e.evaluate(argument)
+		if err != nil {
+			return nil, err
+		}
+		if isReferenceArgument(argument, value) {
+			for _, v := range value.writtenValues() {
+				if v.Type == ValueTypeError {
+					return nil, v.Error
+				}
+				if v.isNumeric() {
+					numbers = append(numbers, v.Number)
+				}
+			}
+			continue
+		}
+		if value.Type == ValueTypeEmpty {
+			continue
+		}
+		number, err := value.toNumber()
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+func isDate(v Value) bool {
+	v, err := v.scalar()
+	return err == nil && v.Type == ValueTypeDate
+}
+
+// isReferenceArgument reports whether a function argument came from a cell or range reference rather than a literal.
+func isReferenceArgument(argument formulaNode, value Value) bool {
+	_, isReference := argument.(*referenceNode)
+	return isReference || value.Type == ValueTypeArray
+}
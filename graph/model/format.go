@@ -0,0 +1,329 @@
+package model
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// formatPart is a piece of a format code, either literal text or a placeholder such as 0, # or yyyy.
+type formatPart struct {
+	literal     string
+	placeholder string
+}
+
+// tokenizeFormat splits a format code into literal text and placeholders. Quoted text and characters
+// escaped with a backslash are always literal.
+func tokenizeFormat(format string, placeholders func(rest []rune) int) []formatPart {
+	var parts []formatPart
+	runes := []rune(format)
+	literal := func(text string) {
+		if len(parts) > 0 && parts[len(parts)-1].placeholder == "" {
+			parts[len(parts)-1].literal += text
+			return
+		}
+		parts = append(parts, formatPart{literal: text})
+	}
+	for i := 0; i < len(runes); i++ {
+		switch runes[i] {
+		case '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			literal(string(runes[i+1 : end]))
+			i = end
+			continue
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				literal(string(runes[i]))
+			}
+			continue
+		}
+		if n := placeholders(runes[i:]); n > 0 {
+			parts = append(parts, formatPart{placeholder: string(runes[i : i+n])})
+			i += n - 1
+			continue
+		}
+		literal(string(runes[i]))
+	}
+	return parts
+}
+
+// isDateFormat reports whether a format code formats dates and times rather than numbers.
+func isDateFormat(format string) bool {
+	hasDate := false
+	for _, part := range tokenizeFormat(format, func(rest []rune) int { return 1 }) {
+		if part.placeholder == "" {
+			continue
+		}
+		switch unicode.ToLower([]rune(part.placeholder)[0]) {
+		case 'y', 'm', 'd', 'h', 's':
+			hasDate = true
+		case '0', '#':
+			return false
+		}
+	}
+	return hasDate
+}
+
+// formatValue renders a value with an Excel format code such as "#,##0.00", "0%" or "yyyy-mm-dd".
+// Text that does not read as a number is returned unchanged.
+func formatValue(value Value, format string) string {
+	if lower := strings.ToLower(format); lower == "" || lower == "general" || lower == "@" {
+		return value.String()
+	}
+	number := value.Number
+	switch value.Type {
+	case ValueTypeBoolean:
+		return value.String()
+	case ValueTypeString:
+		parsed := parseValue(value.Text)
+		if !parsed.isNumeric() {
+			return value.Text
+		}
+		number = parsed.Number
+	}
+	if isDateFormat(format) {
+		return formatDate(timeFromSerial(number), format)
+	}
+	return formatNumber(number, format)
+}
+
+// formatNumber supports the common number format codes: 0 and # digits, thousands separators, decimals,
+// percentages, scientific notation and separate positive;negative;zero sections.
+func formatNumber(number float64, format string) string {
+	sections := strings.Split(format, ";")
+	section := sections[0]
+	negative := number < 0
+	switch {
+	case number == 0 && len(sections) > 2:
+		section = sections[2]
+	case negative && len(sections) > 1:
+		section = sections[1]
+		number = -number
+		negative = false
+	}
+	if negative {
+		number = -number
+	}
+
+	parts := tokenizeFormat(section, func(rest []rune) int {
+		switch rest[0] {
+		case '0', '#', '?', '.', ',', '%':
+			return 1
+		case 'E', 'e':
+			if len(rest) > 1 && (rest[1] == '+' || rest[1] == '-') {
+				return 2
+			}
+		}
+		return 0
+	})
+
+	var pattern strings.Builder
+	var prefix, suffix strings.Builder
+	for _, part := range parts {
+		switch {
+		case part.placeholder == "%":
+			number *= 100
+			if pattern.Len() == 0 {
+				prefix.WriteString("%")
+			} else {
+				suffix.WriteString("%")
+			}
+		case part.placeholder != "":
+			pattern.WriteString(part.placeholder)
+		case pattern.Len() == 0:
+			prefix.WriteString(part.literal)
+		default:
+			suffix.WriteString(part.literal)
+		}
+	}
+
+	formatted := formatDigits(number, pattern.String())
+	sign := ""
+	// like Excel the sign goes before literals such as a currency symbol, -$1.00 rather than $-1.00
+	if negative && strings.Trim(formatted, "0.,") != "" {
+		sign = "-"
+	}
+	return sign + prefix.String() + formatted + suffix.String()
+}
+
+// formatDigits renders a non-negative number with a pattern made of 0 # ? . , and E+ placeholders.
+func formatDigits(number float64, pattern string) string {
+	if pattern == "" {
+		return ""
+	}
+	exponentPattern := ""
+	if i := strings.IndexAny(pattern, "Ee"); i >= 0 {
+		exponentPattern = pattern[i+2:]
+		pattern = pattern[:i]
+	}
+
+	integerPattern, decimalPattern, _ := strings.Cut(pattern, ".")
+	grouping := strings.Contains(integerPattern, ",")
+	minIntegers := strings.Count(integerPattern, "0")
+	minDecimals := strings.Count(decimalPattern, "0")
+	maxDecimals := minDecimals + strings.Count(decimalPattern, "#") + strings.Count(decimalPattern, "?")
+
+	exponent := 0
+	if exponentPattern != "" && number != 0 {
+		exponent = int(math.Floor(math.Log10(number)))
+		number /= math.Pow(10, float64(exponent))
+	}
+
+	// round half away from zero like Excel rather than to even
+	scale := math.Pow(10, float64(maxDecimals))
+	text := strconv.FormatFloat(math.Round(number*scale)/scale, 'f', maxDecimals, 64)
+	integers, decimals, _ := strings.Cut(text, ".")
+	decimals = strings.TrimRight(decimals, "0")
+	for len(decimals) < minDecimals {
+		decimals += "0"
+	}
+	integers = strings.TrimLeft(integers, "0")
+	for len(integers) < minIntegers {
+		integers = "0" + integers
+	}
+	if grouping {
+		integers = groupThousands(integers)
+	}
+
+	result := integers
+	if decimals != "" || strings.Contains(pattern, ".") && minDecimals > 0 {
+		result += "." + decimals
+	}
+	if exponentPattern != "" {
+		sign := "+"
+		if exponent < 0 {
+			sign = "-"
+			exponent = -exponent
+		}
+		digits := strconv.Itoa(exponent)
+		for len(digits) < strings.Count(exponentPattern, "0") {
+			digits = "0" + digits
+		}
+		result += "E" + sign + digits
+	}
+	return result
+}
+
+func groupThousands(integers string) string {
+	var grouped strings.Builder
+	for i, digit := range integers {
+		if i > 0 && (len(integers)-i)%3 == 0 {
+			grouped.WriteRune(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return grouped.String()
+}
+
+// dateFormatPlaceholder returns the length of the date or time placeholder at the start of rest.
+func dateFormatPlaceholder(rest []rune) int {
+	upper := strings.ToUpper(string(rest))
+	for _, marker := range []string{"AM/PM", "A/P"} {
+		if strings.HasPrefix(upper, marker) {
+			return len(marker)
+		}
+	}
+	switch unicode.ToLower(rest[0]) {
+	case 'y', 'm', 'd', 'h', 's':
+		n := 1
+		for n < len(rest) && unicode.ToLower(rest[n]) == unicode.ToLower(rest[0]) {
+			n++
+		}
+		return n
+	}
+	return 0
+}
+
+// formatDate renders a time with date and time placeholders such as yyyy, mmm, dd, hh, mm and ss.
+// Like Excel, mm means minutes when it follows hours or precedes seconds.
+func formatDate(t time.Time, format string) string {
+	parts := tokenizeFormat(format, dateFormatPlaceholder)
+	twelveHour := false
+	for _, part := range parts {
+		if upper := strings.ToUpper(part.placeholder); upper == "AM/PM" || upper == "A/P" {
+			twelveHour = true
+		}
+	}
+
+	var result strings.Builder
+	lastPlaceholder := ""
+	for i, part := range parts {
+		placeholder := strings.ToLower(part.placeholder)
+		if placeholder == "" {
+			result.WriteString(part.literal)
+			continue
+		}
+		switch placeholder[0] {
+		case 'y':
+			if len(placeholder) <= 2 {
+				result.WriteString(t.Format("06"))
+			} else {
+				result.WriteString(t.Format("2006"))
+			}
+		case 'm':
+			if len(placeholder) <= 2 && (lastPlaceholder == "h" || nextPlaceholder(parts[i+1:]) == "s") {
+				result.WriteString(padded(t.Minute(), len(placeholder)))
+				break
+			}
+			switch len(placeholder) {
+			case 1, 2:
+				result.WriteString(padded(int(t.Month()), len(placeholder)))
+			case 3:
+				result.WriteString(t.Format("Jan"))
+			default:
+				result.WriteString(t.Format("January"))
+			}
+		case 'd':
+			switch len(placeholder) {
+			case 1, 2:
+				result.WriteString(padded(t.Day(), len(placeholder)))
+			case 3:
+				result.WriteString(t.Format("Mon"))
+			default:
+				result.WriteString(t.Format("Monday"))
+			}
+		case 'h':
+			hour := t.Hour()
+			if twelveHour {
+				hour = (hour+11)%12 + 1
+			}
+			result.WriteString(padded(hour, len(placeholder)))
+		case 's':
+			result.WriteString(padded(t.Second(), len(placeholder)))
+		case 'a':
+			marker := "AM"
+			if t.Hour() >= 12 {
+				marker = "PM"
+			}
+			if placeholder == "a/p" {
+				marker = marker[:1]
+			}
+			result.WriteString(marker)
+		}
+		lastPlaceholder = placeholder[:1]
+	}
+	return result.String()
+}
+
+func nextPlaceholder(parts []formatPart) string {
+	for _, part := range parts {
+		if part.placeholder != "" {
+			return strings.ToLower(part.placeholder[:1])
+		}
+	}
+	return ""
+}
+
+func padded(number int, width int) string {
+	text := strconv.Itoa(number)
+	for len(text) < width {
+		text = "0" + text
+	}
+	return text
+}
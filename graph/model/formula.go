@@ -0,0 +1,247 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"github.com/xuri/efp"
+	"strconv"
+)
+
+// formulaNode is a node in the expression tree built from a formula's efp token stream.
+type formulaNode interface{}
+
+type numberNode struct {
+	value float64
+}
+
+type textNode struct {
+	value string
+}
+
+type booleanNode struct {
+	value bool
+}
+
+// referenceNode is a single cell (A1) or range (A1:B3) reference.
+type referenceNode struct {
+	reference string
+}
+
+// errorNode is an error literal such as #N/A.
+type errorNode struct {
+	code ErrorCode
+}
+
+// emptyNode is an omitted function argument, e.g. the middle argument of =SUM(A1,,B1).
+type emptyNode struct{}
+
+type unaryNode struct {
+	operator string
+	operand  formulaNode
+}
+
+type binaryNode struct {
+	operator string
+	left     formulaNode
+	right    formulaNode
+}
+
+type functionNode struct {
+	name      string
+	arguments []formulaNode
+}
+
+// infixPrecedence follows Excel's operator precedence, higher binds tighter.
+// Negation and percent are handled as prefix/postfix operators and bind tighter than all of these.
+var infixPrecedence = map[string]int{
+	"=":  1,
+	"<>": 1,
+	"<":  1,
+	"<=": 1,
+	">":  1,
+	">=": 1,
+	"&":  2,
+	"+":  3,
+	"-":  3,
+	"*":  4,
+	"/":  4,
+	"^":  5,
+}
+
+type formulaParser struct {
+	tokens   []efp.Token
+	position int
+}
+
+func parseFormula(tokens []efp.Token) (formulaNode, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("empty formula")
+	}
+	p := &formulaParser{tokens: tokens}
+	node, err := p.parseExpression(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.position < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token %q", p.tokens[p.position].TValue)
+	}
+	return node, nil
+}
+
+func (p *formulaParser) peek() *efp.Token {
+	if p.position >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.position]
+}
+
+func (p *formulaParser) next() *efp.Token {
+	token := p.peek()
+	if token != nil {
+		p.position++
+	}
+	return token
+}
+
+// parseExpression parses binary operators using precedence climbing, all of them are left associative.
+func (p *formulaParser) parseExpression(minPrecedence int) (formulaNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token := p.peek()
+		if token == nil || token.TType != efp.TokenTypeOperatorInfix {
+			return left, nil
+		}
+		precedence, ok := infixPrecedence[token.TValue]
+		if !ok {
+			return nil, fmt.Errorf("unsupported operator %q", token.TValue)
+		}
+		if precedence < minPrecedence {
+			return left, nil
+		}
+		p.next()
+		right, err := p.parseExpression(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{operator: token.TValue, left: left, right: right}
+	}
+}
+
+func (p *formulaParser) parseUnary() (formulaNode, error) {
+	token := p.peek()
+	if token != nil && token.TType == efp.TokenTypeOperatorPrefix {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{operator: token.TValue, operand: operand}, nil
+	}
+	operand, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for token = p.peek(); token != nil && token.TType == efp.TokenTypeOperatorPostfix; token = p.peek() {
+		p.next()
+		operand = &unaryNode{operator: token.TValue, operand: operand}
+	}
+	return operand, nil
+}
+
+func (p *formulaParser) parsePrimary() (formulaNode, error) {
+	token := p.next()
+	if token == nil {
+		return nil, errors.New("unexpected end of formula")
+	}
+	switch token.TType {
+	case efp.TokenTypeOperand:
+		return parseOperand(token)
+	case efp.TokenTypeSubexpression:
+		if token.TSubType != efp.TokenSubTypeStart {
+			return nil, errors.New("unexpected )")
+		}
+		node, err := p.parseExpression(0)
+		if err != nil {
+			return nil, err
+		}
+		closing := p.next()
+		if closing == nil || closing.TType != efp.TokenTypeSubexpression || closing.TSubType != efp.TokenSubTypeStop {
+			return nil, errors.New("missing )")
+		}
+		return node, nil
+	case efp.TokenTypeFunction:
+		if token.TSubType != efp.TokenSubTypeStart {
+			return nil, errors.New("unexpected )")
+		}
+		return p.parseFunction(token.TValue)
+	}
+	return nil, fmt.Errorf("unexpected token %q", token.TValue)
+}
+
+// parseFunction parses the arguments of a function call, the Function Start token has already been consumed.
+func (p *formulaParser) parseFunction(name string) (formulaNode, error) {
+	function := &functionNode{name: name}
+	token := p.peek()
+	if token != nil && token.TType == efp.TokenTypeFunction && token.TSubType == efp.TokenSubTypeStop {
+		p.next()
+		return function, nil
+	}
+	for {
+		token = p.peek()
+		if token == nil {
+			return nil, fmt.Errorf("missing ) for %s", name)
+		}
+		if token.TType == efp.TokenTypeArgument || (token.TType == efp.TokenTypeFunction && token.TSubType == efp.TokenSubTypeStop) {
+			function.arguments = append(function.arguments, &emptyNode{})
+		} else {
+			argument, err := p.parseExpression(0)
+			if err != nil {
+				return nil, err
+			}
+			function.arguments = append(function.arguments, argument)
+		}
+		token = p.next()
+		if token == nil {
+			return nil, fmt.Errorf("missing ) for %s", name)
+		}
+		if token.TType == efp.TokenTypeFunction && token.TSubType == efp.TokenSubTypeStop {
+			return function, nil
+		}
+		if token.TType != efp.TokenTypeArgument {
+			return nil, fmt.Errorf("unexpected token %q in %s", token.TValue, name)
+		}
+	}
+}
+
+func parseOperand(token *efp.Token) (formulaNode, error) {
+	switch token.TSubType {
+	case efp.TokenSubTypeNumber:
+		number, err := strconv.ParseFloat(token.TValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", token.TValue)
+		}
+		return &numberNode{value: number}, nil
+	case efp.TokenSubTypeText:
+		return &textNode{value: token.TValue}, nil
+	case efp.TokenSubTypeLogical:
+		return &booleanNode{value: token.TValue == "TRUE"}, nil
+	case efp.TokenSubTypeRange:
+		return &referenceNode{reference: token.TValue}, nil
+	case efp.TokenSubTypeError:
+		if code, ok := parseErrorCode(token.TValue); ok {
+			return &errorNode{code: code}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported operand %s", token.TValue)
+}
+
+func (c *Cell) parseFormula() (formulaNode, error) {
+	tokens, err := c.parseRawValue()
+	if err != nil {
+		return nil, err
+	}
+	return parseFormula(tokens)
+}
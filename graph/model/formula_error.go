@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is a spreadsheet error value as displayed in a cell.
+type ErrorCode string
+
+const (
+	ErrorCodeNull           ErrorCode = "#NULL!"
+	ErrorCodeDivisionByZero ErrorCode = "#DIV/0!"
+	ErrorCodeValue          ErrorCode = "#VALUE!"
+	ErrorCodeRef            ErrorCode = "#REF!"
+	ErrorCodeName           ErrorCode = "#NAME?"
+	ErrorCodeNum            ErrorCode = "#NUM!"
+	ErrorCodeNA             ErrorCode = "#N/A"
+	ErrorCodeCycle          ErrorCode = "#CYCLE!"
+	// ErrorCodeParse is used for formulas that cannot be parsed at all.
+	ErrorCodeParse ErrorCode = "#ERROR!"
+)
+
+// FormulaError is an error value such as #DIV/0!. While a formula is evaluated it travels as a Go error so
+// that it short-circuits the surrounding expression, once evaluation finishes it is stored as an error Value.
+type FormulaError struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (e *FormulaError) Error() string {
+	return fmt.Sprintf("%s %s", e.Code, e.Message)
+}
+
+func newFormulaError(code ErrorCode, format string, args ...interface{}) *FormulaError {
+	return &FormulaError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// defaultErrorMessages explain error values that come from a formula literal such as =#N/A.
+var defaultErrorMessages = map[ErrorCode]string{
+	ErrorCodeNull:           "ranges do not intersect",
+	ErrorCodeDivisionByZero: "division by zero",
+	ErrorCodeValue:          "wrong type of argument",
+	ErrorCodeRef:            "invalid cell reference",
+	ErrorCodeName:           "unknown function or name",
+	ErrorCodeNum:            "invalid number",
+	ErrorCodeNA:             "value not available",
+	ErrorCodeCycle:          "circular reference",
+	ErrorCodeParse:          "formula could not be parsed",
+}
+
+// parseErrorCode recognizes the error literals efp tokenizes, e.g. #REF!.
+func parseErrorCode(code string) (ErrorCode, bool) {
+	_, ok := defaultErrorMessages[ErrorCode(code)]
+	return ErrorCode(code), ok
+}
+
+// errorValueFromError turns an evaluation failure into the error value stored on the cell.
+func errorValueFromError(err error) Value {
+	var formulaError *FormulaError
+	if errors.As(err, &formulaError) {
+		return Value{Type: ValueTypeError, Error: formulaError}
+	}
+	return ErrorValue(ErrorCodeValue, err.Error())
+}
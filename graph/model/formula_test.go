@@ -0,0 +1,224 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/efp"
+	"strings"
+	"testing"
+)
+
+func parseFormulaString(t *testing.T, formula string) formulaNode {
+	ps := efp.ExcelParser()
+	node, err := parseFormula(ps.Parse(formula))
+	assert.NoError(t, err)
+	return node
+}
+
+func TestParseFormula(t *testing.T) {
+	t.Run("multiplication binds tighter than addition", func(t *testing.T) {
+		node := parseFormulaString(t, "=A1+B1*2")
+
+		assert.Equal(t, &binaryNode{
+			operator: "+",
+			left:     &referenceNode{reference: "A1"},
+			right: &binaryNode{
+				operator: "*",
+				left:     &referenceNode{reference: "B1"},
+				right:    &numberNode{value: 2},
+			},
+		}, node)
+	})
+
+	t.Run("parentheses override precedence", func(t *testing.T) {
+		node := parseFormulaString(t, "=(A1-B1)/C1")
+
+		assert.Equal(t, &binaryNode{
+			operator: "/",
+			left: &binaryNode{
+				operator: "-",
+				left:     &referenceNode{reference: "A1"},
+				right:    &referenceNode{reference: "B1"},
+			},
+			right: &referenceNode{reference: "C1"},
+		}, node)
+	})
+
+	t.Run("subtraction is left associative", func(t *testing.T) {
+		node := parseFormulaString(t, "=1-2-3")
+
+		assert.Equal(t, &binaryNode{
+			operator: "-",
+			left:     &binaryNode{operator: "-", left: &numberNode{value: 1}, right: &numberNode{value: 2}},
+			right:    &numberNode{value: 3},
+		}, node)
+	})
+
+	t.Run("unary minus and percent", func(t *testing.T) {
+		node := parseFormulaString(t, "=-A1*50%")
+
+		assert.Equal(t, &binaryNode{
+			operator: "*",
+			left:     &unaryNode{operator: "-", operand: &referenceNode{reference: "A1"}},
+			right:    &unaryNode{operator: "%", operand: &numberNode{value: 50}},
+		}, node)
+	})
+
+	t.Run("nested functions", func(t *testing.T) {
+		node := parseFormulaString(t, "=SUM(A1:A3, MAX(B1:B2), )")
+
+		assert.Equal(t, &functionNode{
+			name: "SUM",
+			arguments: []formulaNode{
+				&referenceNode{reference: "A1:A3"},
+				&functionNode{name: "MAX", arguments: []formulaNode{&referenceNode{reference: "B1:B2"}}},
+				&emptyNode{},
+			},
+		}, node)
+	})
+
+	t.Run("function without arguments", func(t *testing.T) {
+		node := parseFormulaString(t, "=PI()")
+
+		assert.Equal(t, &functionNode{name: "PI"}, node)
+	})
+
+	t.Run("missing operand", func(t *testing.T) {
+		ps := efp.ExcelParser()
+		_, err := parseFormula(ps.Parse("=1+"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("empty formula", func(t *testing.T) {
+		_, err := parseFormula(nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestEvaluateFormula(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "5"},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "10"},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "2.5"},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "words"},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "4"},
+		{ColumnIndex: 2, RowIndex: 0, ComputedValue: "0"},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=A1+B2", "9"},
+		{"=A1+B2*2", "13"},
+		{"=(A1-B2)/A2", "0.1"},
+		{"=SUM(A1:A3)*2", "35"},
+		{"=SUM(A1:A3, 1, B2)", "22.5"},
+		{"=-A1", "-5"},
+		{"=-2^2", "4"},
+		{"=2^3^2", "64"},
+		{"=A2*10%", "1"},
+		{"=B1&\" and \"&A1", "words and 5"},
+		{"=MAX(A1:A3)-MIN(A1:B3)", "7.5"},
+		{"=AVERAGE(A1:A2, SUM(A3, 0.5))", "6"},
+		{"=COUNT(A1:B3)", "4"},
+		{"=A1+Z99", "5"},
+		{"=ROUND", "#NAME?"},
+		{"=ROUND(A1)", "#NAME?"},
+		{"=A1/C1", "#DIV/0!"},
+		{"=AVERAGE(Z1:Z3)", "#DIV/0!"},
+		{"=(-1)^0.5", "#NUM!"},
+		{"=0^-1", "#NUM!"},
+		{"=1E+308*10", "#NUM!"},
+		{"=-1E+308-1E+308", "#NUM!"},
+		{"=SUM(1E+308, 1E+308)", "#NUM!"},
+		{"=1E+308*10*0", "#NUM!"},
+		{"=B1*2", "#VALUE!"},
+		{"=\" 2 \"*2", "4"},
+		{"=\"nan\"+1", "#VALUE!"},
+		{"=\"1_000\"+1", "#VALUE!"},
+		{"=A1:A3", "#VALUE!"},
+		{"=#N/A", "#N/A"},
+		{"=SUM(A1,#REF!)", "#REF!"},
+		{"=1+", "#ERROR!"},
+		{"=SUM()", "#VALUE!"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestFormulaErrorPropagation(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, RawValue: "=1/0", ComputedValue: "#DIV/0!", ComputedType: ValueTypeError, ErrorMessage: "division by zero"},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "10", ComputedType: ValueTypeNumber},
+	}
+
+	t.Run("error values in referenced cells propagate", func(t *testing.T) {
+		c := &Cell{RawValue: "=A2+A1*2"}
+
+		c.setComputedValue(otherCells, Spreadsheet{})
+
+		assert.Equal(t, "#DIV/0!", c.ComputedValue)
+		assert.Equal(t, ValueTypeError, c.ComputedType)
+		assert.Equal(t, "division by zero", c.ErrorMessage)
+	})
+
+	t.Run("error values inside ranges propagate through aggregates", func(t *testing.T) {
+		c := &Cell{RawValue: "=SUM(A1:A2)"}
+
+		c.setComputedValue(otherCells, Spreadsheet{})
+
+		assert.Equal(t, "#DIV/0!", c.ComputedValue)
+	})
+
+	t.Run("COUNT skips error values", func(t *testing.T) {
+		c := &Cell{RawValue: "=COUNT(A1:A2)"}
+
+		c.setComputedValue(otherCells, Spreadsheet{})
+
+		assert.Equal(t, "1", c.ComputedValue)
+		assert.Equal(t, ValueTypeNumber, c.ComputedType)
+		assert.Equal(t, "", c.ErrorMessage)
+	})
+
+	t.Run("COUNT skips error arguments", func(t *testing.T) {
+		for _, tt := range []struct {
+			formula  string
+			expected string
+		}{
+			{"=COUNT(1/0)", "0"},
+			{"=COUNT(A1, 1/0)", "0"},
+			{"=COUNT(A2, 1/0)", "1"},
+			{"=COUNT(Missing, 4)", "1"},
+		} {
+			c := &Cell{RawValue: tt.formula}
+
+			c.setComputedValue(otherCells, Spreadsheet{})
+
+			assert.Equal(t, tt.expected, c.ComputedValue, tt.formula)
+			assert.Equal(t, ValueTypeNumber, c.ComputedType, tt.formula)
+		}
+	})
+
+	t.Run("typed value restores the error message", func(t *testing.T) {
+		value := otherCells[0].TypedValue()
+
+		assert.Equal(t, ValueTypeError, value.Type)
+		assert.Equal(t, ErrorCodeDivisionByZero, value.Error.Code)
+		assert.Equal(t, "division by zero", value.Error.Message)
+	})
+}
@@ -0,0 +1,228 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ArgumentType describes what a function argument expects, it is listed for autocomplete and tells
+// dependency detection which arguments are read.
+type ArgumentType string
+
+const (
+	ArgumentTypeAny     ArgumentType = "ANY"
+	ArgumentTypeNumber  ArgumentType = "NUMBER"
+	ArgumentTypeText    ArgumentType = "TEXT"
+	ArgumentTypeBoolean ArgumentType = "BOOLEAN"
+	ArgumentTypeRange   ArgumentType = "RANGE"
+)
+
+func (e ArgumentType) IsValid() bool {
+	switch e {
+	case ArgumentTypeAny, ArgumentTypeNumber, ArgumentTypeText, ArgumentTypeBoolean, ArgumentTypeRange:
+		return true
+	}
+	return false
+}
+
+func (e ArgumentType) String() string {
+	return string(e)
+}
+
+func (e *ArgumentType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ArgumentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ArgumentType", str)
+	}
+	return nil
+}
+
+func (e ArgumentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FormulaFunctionArgument struct {
+	Name string       `json:"name"`
+	Type ArgumentType `json:"type"`
+}
+
+// UnlimitedArgs is the MaxArgs of functions that take any number of arguments.
+const UnlimitedArgs = -1
+
+// FormulaFunction is a function that can be called from a formula, e.g. SUM.
+type FormulaFunction struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	MinArgs     int    `json:"minArgs"`
+	MaxArgs     int    `json:"maxArgs"`
+	// Arguments describes each argument, for variadic functions the last one repeats.
+	Arguments []FormulaFunctionArgument `json:"arguments"`
+	Evaluate  func(call *FunctionCall) (Value, error)
+}
+
+// ArgumentType returns the type of the i-th argument of a call.
+func (f *FormulaFunction) ArgumentType(i int) ArgumentType {
+	if len(f.Arguments) == 0 {
+		return ArgumentTypeAny
+	}
+	if i >= len(f.Arguments) {
+		return f.Arguments[len(f.Arguments)-1].Type
+	}
+	return f.Arguments[i].Type
+}
+
+// Signature renders how the function is called, e.g. SUM(number, ...), optional arguments are in brackets.
+func (f *FormulaFunction) Signature() string {
+	var arguments []string
+	for i, argument := range f.Arguments {
+		if i < f.MinArgs {
+			arguments = append(arguments, argument.Name)
+		} else {
+			arguments = append(arguments, "["+argument.Name+"]")
+		}
+	}
+	if f.MaxArgs == UnlimitedArgs {
+		arguments = append(arguments, "...")
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(arguments, ", "))
+}
+
+func (f *FormulaFunction) checkArgumentCount(count int) error {
+	if count < f.MinArgs {
+		return newFormulaError(ErrorCodeValue, "%s expects at least %d arguments, got %d", f.Name, f.MinArgs, count)
+	}
+	if f.MaxArgs != UnlimitedArgs && count > f.MaxArgs {
+		return newFormulaError(ErrorCodeValue, "%s expects at most %d arguments, got %d", f.Name, f.MaxArgs, count)
+	}
+	return nil
+}
+
+var (
+	functionsMutex sync.RWMutex
+	functions      = make(map[string]*FormulaFunction)
+)
+
+// RegisterFunction makes a function available to formulas. Function names are case insensitive and
+// registering a name twice is an error.
+func RegisterFunction(function FormulaFunction) error {
+	function.Name = strings.ToUpper(function.Name)
+	if function.Name == "" {
+		return fmt.Errorf("function name is required")
+	}
+	if function.Evaluate == nil {
+		return fmt.Errorf("function %s has no evaluator", function.Name)
+	}
+	if function.MinArgs < 0 || (function.MaxArgs != UnlimitedArgs && function.MaxArgs < function.MinArgs) {
+		return fmt.Errorf("function %s has invalid arity %d to %d", function.Name, function.MinArgs, function.MaxArgs)
+	}
+
+	functionsMutex.Lock()
+	defer functionsMutex.Unlock()
+	if _, ok := functions[function.Name]; ok {
+		return fmt.Errorf("function %s is already registered", function.Name)
+	}
+	functions[function.Name] = &function
+	return nil
+}
+
+// LookupFunction returns the registered function with the given name.
+func LookupFunction(name string) (*FormulaFunction, bool) {
+	functionsMutex.RLock()
+	defer functionsMutex.RUnlock()
+	function, ok := functions[strings.ToUpper(name)]
+	return function, ok
+}
+
+// FormulaFunctions returns every registered function sorted by name.
+func FormulaFunctions() []*FormulaFunction {
+	functionsMutex.RLock()
+	defer functionsMutex.RUnlock()
+	list := make([]*FormulaFunction, 0, len(functions))
+	for _, function := range functions {
+		list = append(list, function)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// mustRegisterFunctions registers the built-in functions, a conflict between them is a programming error.
+func mustRegisterFunctions(list ...FormulaFunction) {
+	for _, function := range list {
+		if err := RegisterFunction(function); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// FunctionCall gives a function access to its arguments. Arguments are only evaluated when asked for,
+// so functions can skip the ones they do not need.
+type FunctionCall struct {
+	Name      string
+	evaluator *evaluator
+	arguments []formulaNode
+}
+
+// Len returns the number of arguments passed.
+func (c *FunctionCall) Len() int {
+	return len(c.arguments)
+}
+
+// Argument evaluates the i-th argument, ranges are returned as an ARRAY value. Error values are returned
+// as the *FormulaError they hold.
+func (c *FunctionCall) Argument(i int) (Value, error) {
+	value, err := c.evaluator.evaluate(c.arguments[i])
+	if err != nil {
+		return Value{}, err
+	}
+	if value.Type == ValueTypeError {
+		return Value{}, value.Error
+	}
+	return value, nil
+}
+
+// IsEmpty reports whether the i-th argument was left out, as in the second argument of =F(1,,3).
+func (c *FunctionCall) IsEmpty(i int) bool {
+	_, empty := c.arguments[i].(*emptyNode)
+	return empty
+}
+
+// IsReference reports whether the i-th argument is a cell or range reference rather than a value.
+func (c *FunctionCall) IsReference(i int) bool {
+	_, isReference := c.arguments[i].(*referenceNode)
+	return isReference
+}
+
+// Number evaluates the i-th argument as a single number.
+func (c *FunctionCall) Number(i int) (float64, error) {
+	value, err := c.Argument(i)
+	if err != nil {
+		return 0, err
+	}
+	return value.toNumber()
+}
+
+// Text evaluates the i-th argument as a single string.
+func (c *FunctionCall) Text(i int) (string, error) {
+	value, err := c.Argument(i)
+	if err != nil {
+		return "", err
+	}
+	return value.toText()
+}
+
+// Numbers collects the numbers of every argument the way SUM does, text and booleans inside referenced
+// ranges are skipped while values passed directly must be convertible to a number.
+func (c *FunctionCall) Numbers() ([]float64, error) {
+	return c.evaluator.numberArguments(c.arguments)
+}
@@ -0,0 +1,262 @@
+package model
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "SUMIF",
+			Description: "Adds the numbers in a range whose cells, or the matching cells of a criteria range, meet a criteria.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "range", Type: ArgumentTypeRange},
+				{Name: "criteria", Type: ArgumentTypeAny},
+				{Name: "sum_range", Type: ArgumentTypeRange},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(call.singleCriteriaTarget(), [][2]int{{0, 1}})
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(sumNumbers(numbers)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "SUMIFS",
+			Description: "Adds the numbers in a range whose matching cells meet every criteria.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   conditionalArguments("sum_range"),
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(0, criteriaPairs(call, 1))
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(sumNumbers(numbers)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "COUNTIF",
+			Description: "Counts the cells in a range that meet a criteria.",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "range", Type: ArgumentTypeRange},
+				{Name: "criteria", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				values, err := call.matchingValues(0, [][2]int{{0, 1}}, true)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(len(values))), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "COUNTIFS",
+			Description: "Counts the positions where every criteria range meets its criteria.",
+			MinArgs:     2,
+			MaxArgs:     UnlimitedArgs,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "criteria_range", Type: ArgumentTypeRange},
+				{Name: "criteria", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				values, err := call.matchingValues(0, criteriaPairs(call, 0), true)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(len(values))), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "AVERAGEIF",
+			Description: "Averages the numbers in a range whose cells, or the matching cells of a criteria range, meet a criteria.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "range", Type: ArgumentTypeRange},
+				{Name: "criteria", Type: ArgumentTypeAny},
+				{Name: "average_range", Type: ArgumentTypeRange},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(call.singleCriteriaTarget(), [][2]int{{0, 1}})
+				if err != nil {
+					return Value{}, err
+				}
+				return averageOfMatches(numbers)
+			},
+		},
+		FormulaFunction{
+			Name:        "AVERAGEIFS",
+			Description: "Averages the numbers in a range whose matching cells meet every criteria.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   conditionalArguments("average_range"),
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(0, criteriaPairs(call, 1))
+				if err != nil {
+					return Value{}, err
+				}
+				return averageOfMatches(numbers)
+			},
+		},
+		FormulaFunction{
+			Name:        "MAXIFS",
+			Description: "Returns the largest number in a range whose matching cells meet every criteria, or 0 when none do.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   conditionalArguments("max_range"),
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(0, criteriaPairs(call, 1))
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(extremeNumber(numbers, func(a, b float64) bool { return a > b })), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "MINIFS",
+			Description: "Returns the smallest number in a range whose matching cells meet every criteria, or 0 when none do.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   conditionalArguments("min_range"),
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.matchingNumbers(0, criteriaPairs(call, 1))
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(extremeNumber(numbers, func(a, b float64) bool { return a < b })), nil
+			},
+		},
+	)
+}
+
+// conditionalArguments describes the arguments of the *IFS functions, a target range followed by
+// repeating criteria range and criteria pairs.
+func conditionalArguments(target string) []FormulaFunctionArgument {
+	return []FormulaFunctionArgument{
+		{Name: target, Type: ArgumentTypeRange},
+		{Name: "criteria_range", Type: ArgumentTypeRange},
+		{Name: "criteria", Type: ArgumentTypeAny},
+	}
+}
+
+// criteriaPairs returns the argument indexes of the criteria range and criteria pairs starting at start.
+// A missing criteria is reported by matchingValues.
+func criteriaPairs(call *FunctionCall, start int) [][2]int {
+	var pairs [][2]int
+	for i := start; i < call.Len(); i += 2 {
+		pairs = append(pairs, [2]int{i, i + 1})
+	}
+	return pairs
+}
+
+// singleCriteriaTarget is the range SUMIF and AVERAGEIF aggregate, the criteria range itself unless a
+// separate one is passed.
+func (c *FunctionCall) singleCriteriaTarget() int {
+	if c.Len() > 2 && !c.IsEmpty(2) {
+		return 2
+	}
+	return 0
+}
+
+// rangeArgument evaluates the i-th argument, which has to be a reference, to the range of values it refers to.
+// Error values inside the range are returned as values rather than failing the call.
+func (c *FunctionCall) rangeArgument(i int) (Value, error) {
+	if !c.IsReference(i) {
+		return Value{}, newFormulaError(ErrorCodeValue, "%s expects a range as argument %d", c.Name, i+1)
+	}
+	return c.evaluator.evaluate(c.arguments[i])
+}
+
+// criteriaRange is a range along with the criteria its cells are matched against.
+type criteriaRange struct {
+	values   Value
+	criteria criteria
+}
+
+// matchingValues returns the values of the target range at every position where each criteria range,
+// given as the argument indexes of the range and its criteria, meets its criteria. All ranges must
+// be the same size. Without blanks the caller ignores blank target values, so only the written cells of the
+// target are tried. Criteria that cannot match a blank likewise limit the positions to the written cells of their range.
+func (c *FunctionCall) matchingValues(target int, pairs [][2]int, blanks bool) ([]Value, error) {
+	targetValues, err := c.rangeArgument(target)
+	if err != nil {
+		return nil, err
+	}
+	rowCount, columnCount := targetValues.size()
+
+	ranges := make([]criteriaRange, 0, len(pairs))
+	var written *rangeValues
+	if !blanks {
+		written = targetValues.Range
+	}
+	for _, pair := range pairs {
+		if pair[1] >= c.Len() {
+			return nil, newFormulaError(ErrorCodeValue, "%s expects a criteria for every criteria range", c.Name)
+		}
+		criteriaValues, err := c.rangeArgument(pair[0])
+		if err != nil {
+			return nil, err
+		}
+		if criteriaRowCount, criteriaColumnCount := criteriaValues.size(); criteriaRowCount != rowCount || criteriaColumnCount != columnCount {
+			return nil, newFormulaError(ErrorCodeValue, "%s ranges must be the same size", c.Name)
+		}
+		value, err := c.scalar(pair[1])
+		if err != nil {
+			return nil, err
+		}
+		criteria := parseCriteria(value)
+		if written == nil && criteriaValues.Range != nil && !criteria.matches(EmptyValue()) {
+			written = criteriaValues.Range
+		}
+		ranges = append(ranges, criteriaRange{values: criteriaValues, criteria: criteria})
+	}
+
+	var values []Value
+	match := func(row int, column int) {
+		for _, r := range ranges {
+			if !r.criteria.matches(r.values.at(row, column)) {
+				return
+			}
+		}
+		values = append(values, targetValues.at(row, column))
+	}
+	if written != nil {
+		for _, cell := range written.cells {
+			match(cell.row, cell.column)
+		}
+		return values, nil
+	}
+	for row := 0; row < rowCount; row++ {
+		for column := 0; column < columnCount; column++ {
+			match(row, column)
+		}
+	}
+	return values, nil
+}
+
+// matchingNumbers is matchingValues restricted to numbers, errors in matching cells propagate.
+func (c *FunctionCall) matchingNumbers(target int, pairs [][2]int) ([]float64, error) {
+	values, err := c.matchingValues(target, pairs, false)
+	if err != nil {
+		return nil, err
+	}
+	var numbers []float64
+	for _, value := range values {
+		if value.Type == ValueTypeError {
+			return nil, value.Error
+		}
+		if value.isNumeric() {
+			numbers = append(numbers, value.Number)
+		}
+	}
+	return numbers, nil
+}
+
+func averageOfMatches(numbers []float64) (Value, error) {
+	if len(numbers) == 0 {
+		return Value{}, newFormulaError(ErrorCodeDivisionByZero, "no cells match the criteria")
+	}
+	return NumberValue(sumNumbers(numbers) / float64(len(numbers))), nil
+}
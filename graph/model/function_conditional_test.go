@@ -0,0 +1,122 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestParseCriteria(t *testing.T) {
+	tests := []struct {
+		criteria Value
+		value    Value
+		expected bool
+	}{
+		{StringValue(">10"), NumberValue(11), true},
+		{StringValue(">10"), NumberValue(10), false},
+		{StringValue(">10"), StringValue("zzz"), false},
+		{StringValue("<=2024-01-31"), DateValue(timeFromSerial(45300)), true},
+		{StringValue("<>foo"), StringValue("FOO"), false},
+		{StringValue("<>foo"), EmptyValue(), true},
+		{StringValue("<>"), EmptyValue(), false},
+		{StringValue("<>"), NumberValue(0), true},
+		{StringValue("app*"), StringValue("Apples"), true},
+		{StringValue("app*"), StringValue("pineapple"), false},
+		{StringValue("?at"), StringValue("cat"), true},
+		{StringValue("?at"), StringValue("chat"), false},
+		{StringValue("100~*"), StringValue("100*"), true},
+		{StringValue("100~*"), StringValue("1000"), false},
+		{StringValue("5"), StringValue("5"), true},
+		{NumberValue(1000), StringValue("1000"), true},
+		{NumberValue(1000), StringValue("1_000"), false},
+		{StringValue(""), EmptyValue(), true},
+		{StringValue(""), NumberValue(0), false},
+		{NumberValue(5), NumberValue(5), true},
+		{NumberValue(0), EmptyValue(), false},
+		{BooleanValue(true), BooleanValue(true), true},
+		{BooleanValue(true), NumberValue(1), false},
+		{StringValue("<m"), StringValue("apple"), true},
+		{StringValue("<m"), NumberValue(1), false},
+		{StringValue("=#N/A"), ErrorValue(ErrorCodeNA, ""), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.criteria.String()+" "+test.value.String(), func(t *testing.T) {
+			assert.Equal(t, test.expected, parseCriteria(test.criteria).matches(test.value))
+		})
+	}
+}
+
+func TestConditionalFunctions(t *testing.T) {
+	// A: category, B: amount, C: month
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "Rent", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "1200", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 2, RowIndex: 0, ComputedValue: "1", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "Food", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "300", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 2, RowIndex: 1, ComputedValue: "1", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "Food", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 2, ComputedValue: "250", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 2, RowIndex: 2, ComputedValue: "2", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 3, ComputedValue: "Fuel", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 3, ComputedValue: "n/a", ComputedType: ValueTypeString},
+		{ColumnIndex: 2, RowIndex: 3, ComputedValue: "2", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 4, RowIndex: 0, ComputedValue: "Food", ComputedType: ValueTypeString},
+		{ColumnIndex: 5, RowIndex: 0, ComputedValue: "#DIV/0!", ComputedType: ValueTypeError},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=SUMIF(B1:B4, \">280\")", "1500"},
+		{"=SUMIF(A1:A4, \"Food\", B1:B4)", "550"},
+		{"=SUMIF(A1:A4, E1, B1:B4)", "550"},
+		{"=SUMIF(A1:A4, \"F*\", B1:B4)", "550"},
+		{"=SUMIF(A1:A4, \"<>Food\", B1:B4)", "1200"},
+		{"=SUMIF(A1:A4, \"Food\", B1:B3)", "#VALUE!"},
+		{"=SUMIF(5, \">1\")", "#VALUE!"},
+		{"=SUMIFS(B1:B4, A1:A4, \"Food\", C1:C4, 2)", "250"},
+		{"=SUMIFS(B1:B4, A1:A4, \"Food\", C1:C4)", "#VALUE!"},
+		{"=COUNTIF(A1:A4, \"F???\")", "3"},
+		{"=COUNTIF(B1:B4, \"<>\")", "4"},
+		{"=COUNTIF(D1:D4, \"\")", "4"},
+		{"=COUNTIFS(A1:A4, \"F*\", C1:C4, \">1\")", "2"},
+		{"=AVERAGEIF(A1:A4, \"Food\", B1:B4)", "275"},
+		{"=AVERAGEIF(A1:A4, \"Fuel\", B1:B4)", "#DIV/0!"},
+		{"=AVERAGEIFS(B1:B4, C1:C4, 1)", "750"},
+		{"=MAXIFS(B1:B4, A1:A4, \"Food\")", "300"},
+		{"=MINIFS(B1:B4, A1:A4, \"Food\", C1:C4, \">=1\")", "250"},
+		{"=MAXIFS(B1:B4, A1:A4, \"Travel\")", "0"},
+		{"=SUMIF(E1:F1, \"Food\", F1:G1)", "#DIV/0!"},
+		{"=COUNTIF(E1:F1, \"Food\")", "1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("criteria and sum ranges are both dependencies", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 3, RowIndex: 0, RawValue: "=SUMIFS(B1:B4, A1:A4, \"Food\", C1:C4, E1)"},
+		}
+		graph := buildDependencyGraph(cells, Spreadsheet{})
+
+		for _, precedent := range []cellKey{{column: 0, row: 3}, {column: 1, row: 0}, {column: 2, row: 2}, {column: 4, row: 0}} {
+			assert.Equal(t, []cellKey{{column: 3, row: 0}}, graph.directDependents(precedent))
+		}
+		assert.Empty(t, graph.directDependents(cellKey{column: 1, row: 4}))
+	})
+}
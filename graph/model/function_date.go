@@ -0,0 +1,358 @@
+package model
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// now is the current time used by TODAY and NOW, replaced in tests.
+var now = time.Now
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "DATE",
+			Description: "Returns the date of a year, month and day. Months and days outside of their range roll over, as in =DATE(2024, 14, 1) for 2025-02-01.",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "year", Type: ArgumentTypeNumber},
+				{Name: "month", Type: ArgumentTypeNumber},
+				{Name: "day", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				var parts [3]int
+				for i := range parts {
+					number, err := call.Number(i)
+					if err != nil {
+						return Value{}, err
+					}
+					parts[i], err = call.datePart(math.Floor(number))
+					if err != nil {
+						return Value{}, err
+					}
+				}
+				year := parts[0]
+				// like Excel, years below 1900 are counted from 1900
+				if year >= 0 && year < 1900 {
+					year += 1900
+				}
+				if year > 9999 {
+					return Value{}, newFormulaError(ErrorCodeNum, "DATE is outside of the supported range")
+				}
+				return call.dateResult(time.Date(year, time.Month(parts[1]), parts[2], 0, 0, 0, 0, time.UTC))
+			},
+		},
+		FormulaFunction{
+			Name:        "TODAY",
+			Description: "Returns the current date. It is computed when the cell is saved or recalculated.",
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				year, month, day := now().Date()
+				return DateValue(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "NOW",
+			Description: "Returns the current date and time. It is computed when the cell is saved or recalculated.",
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				t := now()
+				return DateValue(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "YEAR",
+			Description: "Returns the year of a date.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "date", Type: ArgumentTypeAny}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(date.Year())), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "MONTH",
+			Description: "Returns the month of a date, from 1 for January to 12 for December.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "date", Type: ArgumentTypeAny}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(date.Month())), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "DAY",
+			Description: "Returns the day of the month of a date.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "date", Type: ArgumentTypeAny}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(date.Day())), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "EDATE",
+			Description: "Returns the date a number of months before or after a date, on the last day of the month when the day does not exist.",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "start_date", Type: ArgumentTypeAny},
+				{Name: "months", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, months, err := call.dateAndMonths()
+				if err != nil {
+					return Value{}, err
+				}
+				return call.dateResult(addMonths(date, months))
+			},
+		},
+		FormulaFunction{
+			Name:        "EOMONTH",
+			Description: "Returns the last day of the month a number of months before or after a date.",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "start_date", Type: ArgumentTypeAny},
+				{Name: "months", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, months, err := call.dateAndMonths()
+				if err != nil {
+					return Value{}, err
+				}
+				return call.dateResult(time.Date(date.Year(), date.Month()+time.Month(months)+1, 0, 0, 0, 0, 0, time.UTC))
+			},
+		},
+		FormulaFunction{
+			Name:        "DATEDIF",
+			Description: "Returns the difference between two dates in complete years (Y), months (M) or days (D), or the days (MD), months (YM) or days (YD) left over after the larger units.",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "start_date", Type: ArgumentTypeAny},
+				{Name: "end_date", Type: ArgumentTypeAny},
+				{Name: "unit", Type: ArgumentTypeText},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				start, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				end, err := call.date(1)
+				if err != nil {
+					return Value{}, err
+				}
+				unit, err := call.Text(2)
+				if err != nil {
+					return Value{}, err
+				}
+				start, end = truncateDay(start), truncateDay(end)
+				if end.Before(start) {
+					return Value{}, newFormulaError(ErrorCodeNum, "DATEDIF end date is before the start date")
+				}
+				months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+				if end.Day() < start.Day() {
+					months--
+				}
+				switch strings.ToUpper(unit) {
+				case "Y":
+					return NumberValue(float64(months / 12)), nil
+				case "M":
+					return NumberValue(float64(months)), nil
+				case "D":
+					return NumberValue(daysBetween(start, end)), nil
+				case "MD":
+					return NumberValue(daysBetween(addMonths(start, months), end)), nil
+				case "YM":
+					return NumberValue(float64(months % 12)), nil
+				case "YD":
+					return NumberValue(daysBetween(addMonths(start, months/12*12), end)), nil
+				}
+				return Value{}, newFormulaError(ErrorCodeNum, "unknown DATEDIF unit %q", unit)
+			},
+		},
+		FormulaFunction{
+			Name:        "NETWORKDAYS",
+			Description: "Counts the working days, Monday to Friday, between two dates including both, leaving out a range of holidays.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "start_date", Type: ArgumentTypeAny},
+				{Name: "end_date", Type: ArgumentTypeAny},
+				{Name: "holidays", Type: ArgumentTypeRange},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				start, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				end, err := call.date(1)
+				if err != nil {
+					return Value{}, err
+				}
+				holidays := map[time.Time]bool{}
+				if call.Len() > 2 && !call.IsEmpty(2) {
+					array, err := call.arrayArgument(2)
+					if err != nil {
+						return Value{}, err
+					}
+					for _, value := range array.writtenValues() {
+						if value.Type == ValueTypeEmpty {
+							continue
+						}
+						if value.Type == ValueTypeError {
+							return Value{}, value.Error
+						}
+						number, err := value.toNumber()
+						if err != nil {
+							return Value{}, err
+						}
+						holidays[truncateDay(timeFromSerial(number))] = true
+					}
+				}
+
+				sign := 1.0
+				start, end = truncateDay(start), truncateDay(end)
+				if end.Before(start) {
+					start, end, sign = end, start, -1
+				}
+				days := 0
+				for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+					if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday && !holidays[day] {
+						days++
+					}
+				}
+				return NumberValue(sign * float64(days)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "WEEKDAY",
+			Description: "Returns the day of the week of a date. Type 1 counts from 1 on Sunday, 2 from 1 on Monday, 3 from 0 on Monday, and 11 to 17 from 1 on Monday to Sunday.",
+			MinArgs:     1,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "date", Type: ArgumentTypeAny},
+				{Name: "type", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				date, err := call.date(0)
+				if err != nil {
+					return Value{}, err
+				}
+				returnType, err := call.optionalNumber(1, 1)
+				if err != nil {
+					return Value{}, err
+				}
+				// firstDay is the weekday counted as 1, or 0 for type 3
+				weekday := int(date.Weekday())
+				var firstDay, offset int
+				switch {
+				case returnType == 1:
+					firstDay, offset = 0, 1
+				case returnType == 2:
+					firstDay, offset = 1, 1
+				case returnType == 3:
+					firstDay, offset = 1, 0
+				case returnType >= 11 && returnType <= 17 && returnType == math.Floor(returnType):
+					firstDay, offset = (int(returnType)-10)%7, 1
+				default:
+					return Value{}, newFormulaError(ErrorCodeNum, "unknown WEEKDAY type %v", returnType)
+				}
+				return NumberValue(float64((weekday-firstDay+7)%7 + offset)), nil
+			},
+		},
+	)
+}
+
+// date evaluates the i-th argument as a date, from a serial number or text that reads as a date.
+func (c *FunctionCall) date(i int) (time.Time, error) {
+	serial, err := c.Number(i)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if serial < 0 {
+		return time.Time{}, newFormulaError(ErrorCodeNum, "%s expects a date, got the negative serial %v", c.Name, serial)
+	}
+	if serial >= dateSerial(maxDate)+1 {
+		return time.Time{}, newFormulaError(ErrorCodeNum, "%s expects a date, got the serial %v after 9999-12-31", c.Name, serial)
+	}
+	return timeFromSerial(serial), nil
+}
+
+// maxDate is the last day a date function returns, like in Excel.
+var maxDate = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// serialDateValue returns a date serial number as a date, or a #NUM! error when it is before serialEpoch or
+// after maxDate.
+func serialDateValue(serial float64) (Value, error) {
+	if !(serial >= 0 && serial < dateSerial(maxDate)+1) {
+		return Value{}, newFormulaError(ErrorCodeNum, "the date %v is outside of the supported range", serial)
+	}
+	return Value{Type: ValueTypeDate, Number: serial}, nil
+}
+
+// dateResult returns date as a value, or a #NUM! error when it is before serialEpoch or after maxDate.
+func (c *FunctionCall) dateResult(date time.Time) (Value, error) {
+	if date.Before(serialEpoch) || date.After(maxDate) {
+		return Value{}, newFormulaError(ErrorCodeNum, "%s is outside of the supported range", c.Name)
+	}
+	return DateValue(date), nil
+}
+
+// dateAndMonths reads the start date and month count arguments of EDATE and EOMONTH, fractional months are truncated.
+func (c *FunctionCall) dateAndMonths() (time.Time, int, error) {
+	date, err := c.date(0)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	months, err := c.Number(1)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	whole, err := c.datePart(math.Trunc(months))
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	return truncateDay(date), whole, nil
+}
+
+// datePart converts a whole number of years, months or days to an int. Like in Excel, numbers that do not fit
+// in 32 bits are #NUM!, which also keeps them from overflowing the int.
+func (c *FunctionCall) datePart(number float64) (int, error) {
+	if number < math.MinInt32 || number > math.MaxInt32 {
+		return 0, newFormulaError(ErrorCodeNum, "%s argument %v is outside of the supported range", c.Name, number)
+	}
+	return int(number), nil
+}
+
+// addMonths moves a date by a number of months, keeping the day unless the month is shorter.
+func addMonths(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+func truncateDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(start time.Time, end time.Time) float64 {
+	return math.Round(dateSerial(end) - dateSerial(start))
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDateFunctions(t *testing.T) {
+	now = func() time.Time { return time.Date(2024, 3, 5, 14, 30, 15, 0, time.Local) }
+	defer func() { now = time.Now }()
+
+	// A1 and A2 are dates, A3 a date and time, B1:B2 holidays
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "2024-01-31", ComputedType: ValueTypeDate},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "2024-03-15", ComputedType: ValueTypeDate},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "2024-03-15T18:45:00", ComputedType: ValueTypeDate},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "2024-03-11", ComputedType: ValueTypeDate},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "2024-03-16", ComputedType: ValueTypeDate},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=A2-A1", "44"},
+		{"=A1+30", "2024-03-01"},
+		{"=7+A1", "2024-02-07"},
+		{"=A2-14", "2024-03-01"},
+		{"=A3-A2", "0.78125"},
+		{"=A1*1", "45322"},
+		{"=\"2024-03-15\"-A1", "44"},
+		{"=DATE(2024, 2, 29)", "2024-02-29"},
+		{"=DATE(2024, 14, 1)", "2025-02-01"},
+		{"=DATE(2024, 3, 0)", "2024-02-29"},
+		{"=DATE(124, 1, 1)", "2024-01-01"},
+		{"=DATE(-1, 1, 1)", "#NUM!"},
+		{"=DATE(2200, 1, 1)", "2200-01-01"},
+		{"=YEAR(DATE(2250, 6, 1))", "2250"},
+		{"=DATE(9999, 12, 31)", "9999-12-31"},
+		{"=DATE(9999, 13, 1)", "#NUM!"},
+		{"=DATE(2024, 1, 1E+300)", "#NUM!"},
+		{"=DATE(2024, -1E+19, 1)", "#NUM!"},
+		{"=DATE(1E+300, 1, 1)", "#NUM!"},
+		{"=DATE(2024, 1, 1E+6)", "4761-11-27"},
+		{"=DATE(9999, 12, 31)+1", "#NUM!"},
+		{"=DATE(9999, 12, 30)+1", "9999-12-31"},
+		{"=DATE(1900, 1, 1)-3", "#NUM!"},
+		{"=1-DATE(2024, 1, 1)", "-45291"},
+		{"=DATE(2300, 1, 1)-DATE(1900, 1, 1)", "146097"},
+		{"=DATE(2024, 1, 1)-DATE(2023, 1, 1)", "365"},
+		{"=TODAY()", "2024-03-05"},
+		{"=NOW()", "2024-03-05T14:30:15"},
+		{"=YEAR(A2)", "2024"},
+		{"=MONTH(A2)", "3"},
+		{"=DAY(A2)", "15"},
+		{"=DAY(\"3/15/2024\")", "15"},
+		{"=MONTH(45322)", "1"},
+		{"=YEAR(\"soon\")", "#VALUE!"},
+		{"=DAY(-1)", "#NUM!"},
+		{"=EDATE(A1, 1)", "2024-02-29"},
+		{"=EDATE(A1, -2)", "2023-11-30"},
+		{"=EDATE(A2, 12)", "2025-03-15"},
+		{"=EDATE(A2, 3600)", "2324-03-15"},
+		{"=EDATE(A2, 96000)", "#NUM!"},
+		{"=EDATE(DATE(2024, 1, 1), 1E+300)", "#NUM!"},
+		{"=EDATE(DATE(2024, 1, 1), -1E+19)", "#NUM!"},
+		{"=EOMONTH(DATE(2024, 1, 1), 1E+300)", "#NUM!"},
+		{"=EOMONTH(DATE(2199, 12, 15), 2)", "2200-02-28"},
+		{"=DATEDIF(\"1900-01-01\", \"2300-01-01\", \"D\")", "146097"},
+		{"=DAY(3000000)", "#NUM!"},
+		{"=EOMONTH(A2, 0)", "2024-03-31"},
+		{"=EOMONTH(A1, 1)", "2024-02-29"},
+		{"=EOMONTH(A1, -1)", "2023-12-31"},
+		{"=DATEDIF(A1, A2, \"D\")", "44"},
+		{"=DATEDIF(A1, A2, \"M\")", "1"},
+		{"=DATEDIF(\"2020-06-15\", A2, \"Y\")", "3"},
+		{"=DATEDIF(\"2020-06-15\", A2, \"YM\")", "9"},
+		{"=DATEDIF(\"2020-06-15\", \"2024-07-01\", \"YD\")", "16"},
+		{"=DATEDIF(\"2024-01-10\", A2, \"MD\")", "5"},
+		{"=DATEDIF(A2, A1, \"D\")", "#NUM!"},
+		{"=DATEDIF(A1, A2, \"W\")", "#NUM!"},
+		{"=NETWORKDAYS(\"2024-03-04\", A2)", "10"},
+		{"=NETWORKDAYS(\"2024-03-04\", A2, B1:B2)", "9"},
+		{"=NETWORKDAYS(A2, \"2024-03-04\")", "-10"},
+		{"=NETWORKDAYS(\"2024-03-09\", \"2024-03-10\")", "0"},
+		{"=WEEKDAY(A2)", "6"},
+		{"=WEEKDAY(A2, 2)", "5"},
+		{"=WEEKDAY(A2, 3)", "4"},
+		{"=WEEKDAY(A2, 16)", "7"},
+		{"=WEEKDAY(A2, 4)", "#NUM!"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package model
+
+import (
+	"errors"
+)
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "IF",
+			Description: "Returns one value when a condition is TRUE and another when it is FALSE, only the chosen one is evaluated.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "condition", Type: ArgumentTypeBoolean},
+				{Name: "value_if_true", Type: ArgumentTypeAny},
+				{Name: "value_if_false", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				condition, err := call.boolean(0)
+				if err != nil {
+					return Value{}, err
+				}
+				if condition {
+					return call.Argument(1)
+				}
+				if call.Len() < 3 {
+					return BooleanValue(false), nil
+				}
+				return call.Argument(2)
+			},
+		},
+		FormulaFunction{
+			Name:        "IFS",
+			Description: "Returns the value paired with the first condition that is TRUE.",
+			MinArgs:     2,
+			MaxArgs:     UnlimitedArgs,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "condition", Type: ArgumentTypeBoolean},
+				{Name: "value", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				if call.Len()%2 != 0 {
+					return Value{}, newFormulaError(ErrorCodeValue, "IFS expects condition and value pairs")
+				}
+				for i := 0; i < call.Len(); i += 2 {
+					condition, err := call.boolean(i)
+					if err != nil {
+						return Value{}, err
+					}
+					if condition {
+						return call.Argument(i + 1)
+					}
+				}
+				return Value{}, newFormulaError(ErrorCodeNA, "no IFS condition is TRUE")
+			},
+		},
+		FormulaFunction{
+			Name:        "AND",
+			Description: "Returns TRUE when all of its arguments are TRUE.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "logical", Type: ArgumentTypeBoolean}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				booleans, err := call.booleans()
+				if err != nil {
+					return Value{}, err
+				}
+				for _, boolean := range booleans {
+					if !boolean {
+						return BooleanValue(false), nil
+					}
+				}
+				return BooleanValue(true), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "OR",
+			Description: "Returns TRUE when any of its arguments is TRUE.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "logical", Type: ArgumentTypeBoolean}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				booleans, err := call.booleans()
+				if err != nil {
+					return Value{}, err
+				}
+				for _, boolean := range booleans {
+					if boolean {
+						return BooleanValue(true), nil
+					}
+				}
+				return BooleanValue(false), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "XOR",
+			Description: "Returns TRUE when an odd number of its arguments are TRUE.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "logical", Type: ArgumentTypeBoolean}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				booleans, err := call.booleans()
+				if err != nil {
+					return Value{}, err
+				}
+				result := false
+				for _, boolean := range booleans {
+					result = result != boolean
+				}
+				return BooleanValue(result), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "NOT",
+			Description: "Reverses a logical value.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "logical", Type: ArgumentTypeBoolean}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				boolean, err := call.boolean(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return BooleanValue(!boolean), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "IFERROR",
+			Description: "Returns a value, or a fallback when the value is an error. The fallback is only evaluated when needed.",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "value", Type: ArgumentTypeAny},
+				{Name: "value_if_error", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				return call.fallbackOnError(func(*FormulaError) bool { return true })
+			},
+		},
+		FormulaFunction{
+			Name:        "IFNA",
+			Description: "Returns a value, or a fallback when the value is #N/A. The fallback is only evaluated when needed.",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "value", Type: ArgumentTypeAny},
+				{Name: "value_if_na", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				return call.fallbackOnError(func(err *FormulaError) bool { return err.Code == ErrorCodeNA })
+			},
+		},
+		FormulaFunction{
+			Name:        "SWITCH",
+			Description: "Compares an expression with a list of cases and returns the value of the first match, or the default.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "expression", Type: ArgumentTypeAny},
+				{Name: "case", Type: ArgumentTypeAny},
+				{Name: "value", Type: ArgumentTypeAny},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				expression, err := call.scalar(0)
+				if err != nil {
+					return Value{}, err
+				}
+				i := 1
+				for ; i+1 < call.Len(); i += 2 {
+					match, err := call.scalar(i)
+					if err != nil {
+						return Value{}, err
+					}
+					if compareValues(expression, match) == 0 {
+						return call.Argument(i + 1)
+					}
+				}
+				// an odd argument left over is the default
+				if i < call.Len() {
+					return call.Argument(i)
+				}
+				return Value{}, newFormulaError(ErrorCodeNA, "no SWITCH case matches %s", expression)
+			},
+		},
+	)
+}
+
+// scalar evaluates the i-th argument as a single value.
+func (c *FunctionCall) scalar(i int) (Value, error) {
+	value, err := c.Argument(i)
+	if err != nil {
+		return Value{}, err
+	}
+	return value.scalar()
+}
+
+func (c *FunctionCall) boolean(i int) (bool, error) {
+	value, err := c.Argument(i)
+	if err != nil {
+		return false, err
+	}
+	return value.toBoolean()
+}
+
+// booleans collects the logical values of every argument. Like Excel, text and empty cells inside references
+// are skipped, while values passed directly must be convertible, and at least one logical value is required.
+func (c *FunctionCall) booleans() ([]bool, error) {
+	var booleans []bool
+	for i := 0; i < c.Len(); i++ {
+		value, err := c.Argument(i)
+		if err != nil {
+			return nil, err
+		}
+		if isReferenceArgument(c.arguments[i], value) {
+			for _, v := range value.writtenValues() {
+				if v.Type == ValueTypeError {
+					return nil, v.Error
+				}
+				if v.Type == ValueTypeBoolean || v.isNumeric() {
+					boolean, _ := v.toBoolean()
+					booleans = append(booleans, boolean)
+				}
+			}
+			continue
+		}
+		boolean, err := value.toBoolean()
+		if err != nil {
+			return nil, err
+		}
+		booleans = append(booleans, boolean)
+	}
+	if len(booleans) == 0 {
+		return nil, newFormulaError(ErrorCodeValue, "%s has no logical values", c.Name)
+	}
+	return booleans, nil
+}
+
+// fallbackOnError returns the first argument, or the second one when the first evaluates to an error
+// that matches catches.
+func (c *FunctionCall) fallbackOnError(catches func(*FormulaError) bool) (Value, error) {
+	value, err := c.Argument(0)
+	if err == nil {
+		value, err = value.scalar()
+	}
+	var formulaError *FormulaError
+	if errors.As(err, &formulaError) && catches(formulaError) {
+		return c.Argument(1)
+	}
+	return value, err
+}
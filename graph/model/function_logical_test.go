@@ -0,0 +1,98 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestLogicalFunctions(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "5", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "TRUE", ComputedType: ValueTypeBoolean},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "Rent", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "#N/A", ComputedType: ValueTypeError},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "#DIV/0!", ComputedType: ValueTypeError},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=A1>3", "TRUE"},
+		{"=A1<>5", "FALSE"},
+		{"=A1+1>=6", "TRUE"},
+		{"=A3=\"rent\"", "TRUE"},
+		{"=A3<1", "FALSE"},
+		{"=C1=0", "TRUE"},
+		{"=C1=\"\"", "TRUE"},
+		{"=\"a\"&1=\"a1\"", "TRUE"},
+		{"=IF(A1>3, \"over\", \"under\")", "over"},
+		{"=IF(A1>10, \"over\")", "FALSE"},
+		{"=IF(A2, A1*2, 1/0)", "10"},
+		{"=IF(A1<0, 1/0, A3)", "Rent"},
+		{"=IF(\"maybe\", 1, 2)", "#VALUE!"},
+		{"=IFS(A1>10, \"high\", A1>3, \"mid\", TRUE, \"low\")", "mid"},
+		{"=IFS(A1>10, \"high\")", "#N/A"},
+		{"=IFS(A1>10)", "#VALUE!"},
+		{"=AND(A1>3, A2)", "TRUE"},
+		{"=AND(A1:A3, FALSE)", "FALSE"},
+		{"=AND(A3)", "#VALUE!"},
+		{"=OR(A1>10, 0)", "FALSE"},
+		{"=OR(A1:A2)", "TRUE"},
+		{"=XOR(TRUE, TRUE, TRUE)", "TRUE"},
+		{"=XOR(A2, A1>3)", "FALSE"},
+		{"=NOT(A1=5)", "FALSE"},
+		{"=IFERROR(B2, \"none\")", "none"},
+		{"=IFERROR(A1, 1/0)", "5"},
+		{"=IFNA(B1, 0)", "0"},
+		{"=IFNA(B2, 0)", "#DIV/0!"},
+		{"=SWITCH(A3, \"Food\", 1, \"Rent\", 2)", "2"},
+		{"=SWITCH(A1, 1, \"one\", 2, \"two\", \"other\")", "other"},
+		{"=SWITCH(A1, 1, \"one\")", "#N/A"},
+		{"=SWITCH(A1, 5, \"five\", 1/0)", "five"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("comparisons evaluate to booleans", func(t *testing.T) {
+		c := &Cell{RawValue: "=A1>3"}
+
+		c.setComputedValue(otherCells, Spreadsheet{})
+
+		assert.Equal(t, ValueTypeBoolean, c.ComputedType)
+	})
+}
+
+func TestCompareValues(t *testing.T) {
+	t.Run("numbers sort before text and text before booleans", func(t *testing.T) {
+		assert.Equal(t, -1, compareValues(NumberValue(100), StringValue("1")))
+		assert.Equal(t, -1, compareValues(StringValue("zebra"), BooleanValue(false)))
+		assert.Equal(t, 1, compareValues(BooleanValue(true), BooleanValue(false)))
+	})
+
+	t.Run("text is compared case insensitively", func(t *testing.T) {
+		assert.Equal(t, 0, compareValues(StringValue("Apple"), StringValue("aPPLE")))
+		assert.Equal(t, -1, compareValues(StringValue("apple"), StringValue("Banana")))
+	})
+
+	t.Run("empty equals the blank value of the other side", func(t *testing.T) {
+		assert.Equal(t, 0, compareValues(EmptyValue(), NumberValue(0)))
+		assert.Equal(t, 0, compareValues(StringValue(""), EmptyValue()))
+		assert.Equal(t, 0, compareValues(EmptyValue(), BooleanValue(false)))
+		assert.Equal(t, -1, compareValues(EmptyValue(), NumberValue(1)))
+	})
+}
@@ -0,0 +1,357 @@
+package model
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "VLOOKUP",
+			Description: "Looks a value up in the first column of a table and returns the value in the same row of another column.",
+			MinArgs:     3,
+			MaxArgs:     4,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "lookup_value", Type: ArgumentTypeAny},
+				{Name: "table", Type: ArgumentTypeRange},
+				{Name: "column_index", Type: ArgumentTypeNumber},
+				{Name: "approximate_match", Type: ArgumentTypeBoolean},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				return call.tableLookup(false)
+			},
+		},
+		FormulaFunction{
+			Name:        "HLOOKUP",
+			Description: "Looks a value up in the first row of a table and returns the value in the same column of another row.",
+			MinArgs:     3,
+			MaxArgs:     4,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "lookup_value", Type: ArgumentTypeAny},
+				{Name: "table", Type: ArgumentTypeRange},
+				{Name: "row_index", Type: ArgumentTypeNumber},
+				{Name: "approximate_match", Type: ArgumentTypeBoolean},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				return call.tableLookup(true)
+			},
+		},
+		FormulaFunction{
+			Name:        "INDEX",
+			Description: "Returns the value at a row and column of a range, a row or column of 0 returns the whole column or row.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "range", Type: ArgumentTypeRange},
+				{Name: "row", Type: ArgumentTypeNumber},
+				{Name: "column", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				array, err := call.arrayArgument(0)
+				if err != nil {
+					return Value{}, err
+				}
+				row, err := call.index(1)
+				if err != nil {
+					return Value{}, err
+				}
+				column := 0
+				if call.Len() > 2 {
+					column, err = call.index(2)
+					if err != nil {
+						return Value{}, err
+					}
+				}
+				// a single row range is indexed by its columns, as in =INDEX(A1:E1, 3)
+				if rowCount, _ := array.size(); rowCount == 1 && call.Len() == 2 {
+					row, column = 1, row
+				}
+				return indexArray(array, row, column)
+			},
+		},
+		FormulaFunction{
+			Name:        "MATCH",
+			Description: "Returns the position of a value in a row or column. Match type 1 finds the largest value less than or equal, 0 an exact match and -1 the smallest value greater than or equal.",
+			MinArgs:     2,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "lookup_value", Type: ArgumentTypeAny},
+				{Name: "range", Type: ArgumentTypeRange},
+				{Name: "match_type", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				lookup, err := call.scalar(0)
+				if err != nil {
+					return Value{}, err
+				}
+				array, err := call.arrayArgument(1)
+				if err != nil {
+					return Value{}, err
+				}
+				values, ok := vector(array)
+				if !ok {
+					return Value{}, newFormulaError(ErrorCodeNA, "MATCH expects a single row or column")
+				}
+				mode := matchExactOrNextSmaller
+				if call.Len() > 2 && !call.IsEmpty(2) {
+					matchType, err := call.Number(2)
+					if err != nil {
+						return Value{}, err
+					}
+					switch {
+					case matchType == 0:
+						mode = matchWildcard
+					case matchType < 0:
+						mode = matchExactOrNextLarger
+					}
+				}
+				position := findMatch(values, lookup, mode, false)
+				if position < 0 {
+					return Value{}, newFormulaError(ErrorCodeNA, "%s was not found", lookup)
+				}
+				return NumberValue(float64(position + 1)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "XLOOKUP",
+			Description: "Looks a value up in a row or column and returns the matching item of another one, with a fallback when nothing matches.",
+			MinArgs:     3,
+			MaxArgs:     6,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "lookup_value", Type: ArgumentTypeAny},
+				{Name: "lookup_range", Type: ArgumentTypeRange},
+				{Name: "return_range", Type: ArgumentTypeRange},
+				{Name: "if_not_found", Type: ArgumentTypeAny},
+				{Name: "match_mode", Type: ArgumentTypeNumber},
+				{Name: "search_mode", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				lookup, err := call.scalar(0)
+				if err != nil {
+					return Value{}, err
+				}
+				lookupArray, err := call.arrayArgument(1)
+				if err != nil {
+					return Value{}, err
+				}
+				values, ok := vector(lookupArray)
+				if !ok {
+					return Value{}, newFormulaError(ErrorCodeValue, "XLOOKUP expects a single row or column to look in")
+				}
+				returnArray, err := call.arrayArgument(2)
+				if err != nil {
+					return Value{}, err
+				}
+				mode, err := call.optionalNumber(4, 0)
+				if err != nil {
+					return Value{}, err
+				}
+				searchMode, err := call.optionalNumber(5, 1)
+				if err != nil {
+					return Value{}, err
+				}
+				modes := map[float64]matchMode{0: matchExact, -1: matchExactOrNextSmaller, 1: matchExactOrNextLarger, 2: matchWildcard}
+				if _, ok := modes[mode]; !ok {
+					return Value{}, newFormulaError(ErrorCodeValue, "invalid XLOOKUP match mode %v", mode)
+				}
+
+				position := findMatch(values, lookup, modes[mode], searchMode < 0)
+				if position < 0 {
+					if call.Len() > 3 && !call.IsEmpty(3) {
+						return call.Argument(3)
+					}
+					return Value{}, newFormulaError(ErrorCodeNA, "%s was not found", lookup)
+				}
+				// the lookup range runs down a column when it has a single column, across a row otherwise
+				lookupRowCount, lookupColumnCount := lookupArray.size()
+				returnRowCount, returnColumnCount := returnArray.size()
+				if lookupRowCount > 1 || lookupColumnCount == 1 {
+					if returnRowCount != lookupRowCount {
+						return Value{}, newFormulaError(ErrorCodeValue, "XLOOKUP ranges must be the same size")
+					}
+					return indexArray(returnArray, position+1, 0)
+				}
+				if returnColumnCount != lookupColumnCount {
+					return Value{}, newFormulaError(ErrorCodeValue, "XLOOKUP ranges must be the same size")
+				}
+				return indexArray(returnArray, 0, position+1)
+			},
+		},
+	)
+}
+
+// matchMode is how a lookup value is compared with the values it is looked up in.
+type matchMode int
+
+const (
+	matchExact matchMode = iota
+	// matchWildcard is an exact match where text lookup values may contain * and ? wildcards
+	matchWildcard
+	matchExactOrNextSmaller
+	matchExactOrNextLarger
+)
+
+// vectorValue is a value of a single row or column with its zero based position along it.
+type vectorValue struct {
+	position int
+	value    Value
+}
+
+// findMatch returns the position of lookup among values, or -1 when there is none. values are in the order of
+// their positions and leave out the unwritten cells of ranges, as empty cells and values of another kind never
+// match. Approximate modes prefer an exact match over the closest value.
+func findMatch(values []vectorValue, lookup Value, mode matchMode, reverse bool) int {
+	var pattern *criteria
+	if mode == matchWildcard && lookup.Type == ValueTypeString {
+		pattern = &criteria{operator: "=", value: lookup, pattern: wildcardPattern(lookup.Text)}
+	}
+
+	best := -1
+	for n := range values {
+		i := n
+		if reverse {
+			i = len(values) - 1 - n
+		}
+		value := values[i].value
+		if value.Type == ValueTypeEmpty || value.Type == ValueTypeError || comparisonRank(value) != comparisonRank(lookup) {
+			continue
+		}
+		if pattern != nil {
+			if pattern.matches(value) {
+				return values[i].position
+			}
+			continue
+		}
+		comparison := compareValues(value, lookup)
+		if comparison == 0 {
+			return values[i].position
+		}
+		switch {
+		case mode == matchExactOrNextSmaller && comparison < 0:
+			if best < 0 || compareValues(value, values[best].value) > 0 {
+				best = i
+			}
+		case mode == matchExactOrNextLarger && comparison > 0:
+			if best < 0 || compareValues(value, values[best].value) < 0 {
+				best = i
+			}
+		}
+	}
+	if best < 0 {
+		return -1
+	}
+	return values[best].position
+}
+
+// tableLookup implements VLOOKUP, and HLOOKUP when horizontal is set.
+func (c *FunctionCall) tableLookup(horizontal bool) (Value, error) {
+	lookup, err := c.scalar(0)
+	if err != nil {
+		return Value{}, err
+	}
+	table, err := c.arrayArgument(1)
+	if err != nil {
+		return Value{}, err
+	}
+	index, err := c.index(2)
+	if err != nil {
+		return Value{}, err
+	}
+	if index < 1 {
+		return Value{}, newFormulaError(ErrorCodeValue, "%s index must be at least 1", c.Name)
+	}
+	mode := matchExactOrNextSmaller
+	if c.Len() > 3 && !c.IsEmpty(3) {
+		approximate, err := c.boolean(3)
+		if err != nil {
+			return Value{}, err
+		}
+		if !approximate {
+			mode = matchWildcard
+		}
+	}
+
+	position := findMatch(lineValues(table, 0, !horizontal), lookup, mode, false)
+	if position < 0 {
+		return Value{}, newFormulaError(ErrorCodeNA, "%s was not found", lookup)
+	}
+	if horizontal {
+		return indexArray(table, index, position+1)
+	}
+	return indexArray(table, position+1, index)
+}
+
+// arrayArgument evaluates the i-th argument to an array, a single value is a one cell array.
+// Error values inside a range are returned as values.
+func (c *FunctionCall) arrayArgument(i int) (Value, error) {
+	value, err := c.evaluator.evaluate(c.arguments[i])
+	if err != nil {
+		return Value{}, err
+	}
+	if value.Type == ValueTypeError {
+		return Value{}, value.Error
+	}
+	return value, nil
+}
+
+// index evaluates the i-th argument as a 1-based position.
+func (c *FunctionCall) index(i int) (int, error) {
+	if c.IsEmpty(i) {
+		return 0, nil
+	}
+	number, err := c.Number(i)
+	if err != nil {
+		return 0, err
+	}
+	if number < 0 {
+		return 0, newFormulaError(ErrorCodeValue, "%s index %v must not be negative", c.Name, number)
+	}
+	return int(number), nil
+}
+
+func (c *FunctionCall) optionalNumber(i int, fallback float64) (float64, error) {
+	if i >= c.Len() || c.IsEmpty(i) {
+		return fallback, nil
+	}
+	return c.Number(i)
+}
+
+// vector returns the written values of a single row or column.
+func vector(array Value) ([]vectorValue, bool) {
+	rowCount, columnCount := array.size()
+	switch {
+	case rowCount == 1:
+		return lineValues(array, 0, false), true
+	case columnCount == 1:
+		return lineValues(array, 0, true), true
+	}
+	return nil, false
+}
+
+// lineValues returns the written values of the zero based index-th row of an array, or of its column when
+// column is set.
+func lineValues(array Value, index int, column bool) []vectorValue {
+	var values []vectorValue
+	for _, cell := range array.writtenCells() {
+		switch {
+		case column && cell.column == index:
+			values = append(values, vectorValue{position: cell.row, value: cell.value})
+		case !column && cell.row == index:
+			values = append(values, vectorValue{position: cell.column, value: cell.value})
+		}
+	}
+	return values
+}
+
+// indexArray returns the value at a 1-based row and column, 0 selects the whole column or row.
+func indexArray(array Value, row int, column int) (Value, error) {
+	rowCount, columnCount := array.size()
+	if row > rowCount || column > columnCount {
+		return Value{}, newFormulaError(ErrorCodeRef, "index %d,%d is outside of the range", row, column)
+	}
+	switch {
+	case row > 0 && column > 0:
+		return array.at(row-1, column-1), nil
+	case row > 0:
+		return array.slice(row-1, 0, 1, columnCount), nil
+	case column > 0:
+		return array.slice(0, column-1, rowCount, 1), nil
+	}
+	return array, nil
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestLookupFunctions(t *testing.T) {
+	// A1:C4 is a price table sorted by its first column, E1:H2 a horizontal tax table
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "0", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "Apple", ComputedType: ValueTypeString},
+		{ColumnIndex: 2, RowIndex: 0, ComputedValue: "1.5", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "10", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "Banana", ComputedType: ValueTypeString},
+		{ColumnIndex: 2, RowIndex: 1, ComputedValue: "0.25", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "20", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 2, ComputedValue: "Cherry", ComputedType: ValueTypeString},
+		{ColumnIndex: 2, RowIndex: 2, ComputedValue: "#DIV/0!", ComputedType: ValueTypeError},
+		{ColumnIndex: 0, RowIndex: 3, ComputedValue: "30", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 3, ComputedValue: "Date", ComputedType: ValueTypeString},
+		{ColumnIndex: 2, RowIndex: 3, ComputedValue: "4", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 4, RowIndex: 0, ComputedValue: "low", ComputedType: ValueTypeString},
+		{ColumnIndex: 5, RowIndex: 0, ComputedValue: "mid", ComputedType: ValueTypeString},
+		{ColumnIndex: 6, RowIndex: 0, ComputedValue: "high", ComputedType: ValueTypeString},
+		{ColumnIndex: 4, RowIndex: 1, ComputedValue: "0.1", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 5, RowIndex: 1, ComputedValue: "0.2", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 6, RowIndex: 1, ComputedValue: "0.4", ComputedType: ValueTypeNumber},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=VLOOKUP(10, A1:C4, 2, FALSE)", "Banana"},
+		{"=VLOOKUP(15, A1:C4, 2)", "Banana"},
+		{"=VLOOKUP(99, A1:C4, 3, TRUE)", "4"},
+		{"=VLOOKUP(-1, A1:C4, 2)", "#N/A"},
+		{"=VLOOKUP(15, A1:C4, 2, FALSE)", "#N/A"},
+		{"=VLOOKUP(\"ch*\", B1:C4, 2, FALSE)", "#DIV/0!"},
+		{"=VLOOKUP(\"date\", B1:C4, 2, FALSE)", "4"},
+		{"=VLOOKUP(10, A1:C4, 4, FALSE)", "#REF!"},
+		{"=VLOOKUP(10, A1:C4, 0, FALSE)", "#VALUE!"},
+		{"=HLOOKUP(\"mid\", E1:G2, 2, FALSE)", "0.2"},
+		{"=HLOOKUP(\"none\", E1:G2, 2, FALSE)", "#N/A"},
+		{"=INDEX(A1:C4, 2, 3)", "0.25"},
+		{"=INDEX(B1:B4, 3)", "Cherry"},
+		{"=INDEX(E1:G1, 3)", "high"},
+		{"=SUM(INDEX(A1:C4, 0, 1))", "60"},
+		{"=SUM(INDEX(A1:C4, 4, 0))", "34"},
+		{"=INDEX(A1:C4, 5, 1)", "#REF!"},
+		{"=MATCH(\"Cherry\", B1:B4, 0)", "3"},
+		{"=MATCH(25, A1:A4)", "3"},
+		{"=MATCH(25, A1:A4, 0)", "#N/A"},
+		{"=MATCH(\"b*\", B1:B4, 0)", "2"},
+		{"=MATCH(25, A1:A4, -1)", "4"},
+		{"=MATCH(1, A1:B4, 0)", "#N/A"},
+		{"=INDEX(C1:C4, MATCH(\"Date\", B1:B4, 0))", "4"},
+		{"=XLOOKUP(\"Banana\", B1:B4, C1:C4)", "0.25"},
+		{"=XLOOKUP(\"Kiwi\", B1:B4, C1:C4)", "#N/A"},
+		{"=XLOOKUP(\"Kiwi\", B1:B4, C1:C4, \"missing\")", "missing"},
+		{"=XLOOKUP(\"Banana\", B1:B4, C1:C4, 1/0)", "0.25"},
+		{"=XLOOKUP(25, A1:A4, B1:B4, , -1)", "Cherry"},
+		{"=XLOOKUP(25, A1:A4, B1:B4, , 1)", "Date"},
+		{"=XLOOKUP(\"?ate\", B1:B4, A1:A4, , 2)", "30"},
+		{"=XLOOKUP(\"high\", E1:G1, E2:G2)", "0.4"},
+		{"=SUM(XLOOKUP(20, A1:A4, A1:B4))", "20"},
+		{"=XLOOKUP(20, A1:A4, C1:C3)", "#VALUE!"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("XLOOKUP searches from the end with a negative search mode", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, ComputedValue: "x", ComputedType: ValueTypeString},
+			{ColumnIndex: 0, RowIndex: 1, ComputedValue: "x", ComputedType: ValueTypeString},
+		}
+		c := &Cell{RawValue: "=XLOOKUP(\"x\", A1:A2, B1:B2, , 0, -1)"}
+		cells = append(cells, Cell{ColumnIndex: 1, RowIndex: 0, ComputedValue: "first"}, Cell{ColumnIndex: 1, RowIndex: 1, ComputedValue: "last"})
+		result, err := c.ComputeValueFromRaw(cells)
+		assert.NoError(t, err)
+		assert.Equal(t, "last", result)
+
+		c = &Cell{RawValue: "=XLOOKUP(\"x\", A1:A2, B1:B2)"}
+		result, err = c.ComputeValueFromRaw(cells)
+		assert.NoError(t, err)
+		assert.Equal(t, "first", result)
+	})
+}
@@ -0,0 +1,126 @@
+package model
+
+import "errors"
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "SUM",
+			Description: "Adds numbers and the numbers in ranges.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "value", Type: ArgumentTypeNumber}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.Numbers()
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(sumNumbers(numbers)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "AVERAGE",
+			Description: "Returns the arithmetic mean of numbers and the numbers in ranges.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "value", Type: ArgumentTypeNumber}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.Numbers()
+				if err != nil {
+					return Value{}, err
+				}
+				if len(numbers) == 0 {
+					return Value{}, newFormulaError(ErrorCodeDivisionByZero, "AVERAGE of no numbers")
+				}
+				return NumberValue(sumNumbers(numbers) / float64(len(numbers))), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "MAX",
+			Description: "Returns the largest number, or 0 when there are none.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "value", Type: ArgumentTypeNumber}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.Numbers()
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(extremeNumber(numbers, func(a, b float64) bool { return a > b })), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "MIN",
+			Description: "Returns the smallest number, or 0 when there are none.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "value", Type: ArgumentTypeNumber}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				numbers, err := call.Numbers()
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(extremeNumber(numbers, func(a, b float64) bool { return a < b })), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "COUNT",
+			Description: "Counts the numbers in its arguments and ranges.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "value", Type: ArgumentTypeAny}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				return call.evaluator.countNumbers(call.arguments)
+			},
+		},
+	)
+}
+
+func (e *evaluator) countNumbers(arguments []formulaNode) (Value, error) {
+	count := 0
+	for _, argument := range arguments {
+		value, err := e.evaluate(argument)
+		var formulaError *FormulaError
+		if errors.As(err, &formulaError) {
+			// error values are not numbers, so they are skipped rather than propagated
+			continue
+		}
+		if err != nil {
+			return Value{}, err
+		}
+		if isReferenceArgument(argument, value) {
+			for _, v := range value.writtenValues() {
+				if v.isNumeric() {
+					count++
+				}
+			}
+			continue
+		}
+		if _, err := value.toNumber(); err == nil && value.Type != ValueTypeEmpty {
+			count++
+		}
+	}
+	return NumberValue(float64(count)), nil
+}
+
+func sumNumbers(numbers []float64) float64 {
+	var sum float64
+	for _, number := range numbers {
+		sum += number
+	}
+	return sum
+}
+
+// extremeNumber returns the number that wins every comparison with better, or 0 when there are none.
+func extremeNumber(numbers []float64, better func(a, b float64) bool) float64 {
+	if len(numbers) == 0 {
+		return 0
+	}
+	extreme := numbers[0]
+	for _, number := range numbers[1:] {
+		if better(number, extreme) {
+			extreme = number
+		}
+	}
+	return extreme
+}
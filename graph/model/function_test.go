@@ -0,0 +1,74 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRegisterFunction(t *testing.T) {
+	t.Run("registered functions can be called from formulas", func(t *testing.T) {
+		err := RegisterFunction(FormulaFunction{
+			Name:      "margin",
+			MinArgs:   2,
+			MaxArgs:   2,
+			Arguments: []FormulaFunctionArgument{{Name: "revenue", Type: ArgumentTypeNumber}, {Name: "cost", Type: ArgumentTypeNumber}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				revenue, err := call.Number(0)
+				if err != nil {
+					return Value{}, err
+				}
+				cost, err := call.Number(1)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue((revenue - cost) / revenue), nil
+			},
+		})
+		assert.NoError(t, err)
+
+		otherCells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, ComputedValue: "200"},
+		}
+		c := &Cell{RawValue: "=MARGIN(A1, 150)"}
+		result, err := c.ComputeValueFromRaw(otherCells)
+		assert.NoError(t, err)
+		assert.Equal(t, "0.25", result)
+
+		c = &Cell{RawValue: "=MARGIN(A1)"}
+		result, err = c.ComputeValueFromRaw(otherCells)
+		assert.EqualError(t, err, "#VALUE! MARGIN expects at least 2 arguments, got 1")
+		assert.Equal(t, "#VALUE!", result)
+
+		function, ok := LookupFunction("Margin")
+		assert.True(t, ok)
+		assert.Equal(t, "MARGIN(revenue, cost)", function.Signature())
+	})
+
+	t.Run("names can only be registered once", func(t *testing.T) {
+		err := RegisterFunction(FormulaFunction{Name: "SUM", MaxArgs: UnlimitedArgs, Evaluate: func(call *FunctionCall) (Value, error) {
+			return EmptyValue(), nil
+		}})
+
+		assert.EqualError(t, err, "function SUM is already registered")
+	})
+
+	t.Run("functions need an evaluator and a valid arity", func(t *testing.T) {
+		assert.EqualError(t, RegisterFunction(FormulaFunction{Name: "NOOP"}), "function NOOP has no evaluator")
+		assert.EqualError(t, RegisterFunction(FormulaFunction{Name: "NOOP", MinArgs: 2, MaxArgs: 1, Evaluate: func(call *FunctionCall) (Value, error) {
+			return EmptyValue(), nil
+		}}), "function NOOP has invalid arity 2 to 1")
+	})
+}
+
+func TestFormulaFunctions(t *testing.T) {
+	t.Run("lists functions sorted by name", func(t *testing.T) {
+		functions := FormulaFunctions()
+
+		for i := 1; i < len(functions); i++ {
+			assert.Less(t, functions[i-1].Name, functions[i].Name)
+		}
+		sum, _ := LookupFunction("SUM")
+		assert.Contains(t, functions, sum)
+		assert.Equal(t, "SUM(value, ...)", sum.Signature())
+	})
+}
@@ -0,0 +1,422 @@
+package model
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+func init() {
+	mustRegisterFunctions(
+		FormulaFunction{
+			Name:        "CONCAT",
+			Description: "Joins text and the text of ranges together.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate:    concat,
+		},
+		FormulaFunction{
+			Name:        "CONCATENATE",
+			Description: "Joins text and the text of ranges together, the same as CONCAT.",
+			MinArgs:     1,
+			MaxArgs:     UnlimitedArgs,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate:    concat,
+		},
+		FormulaFunction{
+			Name:        "TEXTJOIN",
+			Description: "Joins text and the text of ranges with a delimiter, optionally skipping empty values.",
+			MinArgs:     3,
+			MaxArgs:     UnlimitedArgs,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "delimiter", Type: ArgumentTypeText},
+				{Name: "ignore_empty", Type: ArgumentTypeBoolean},
+				{Name: "text", Type: ArgumentTypeText},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				delimiter, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				ignoreEmpty, err := call.boolean(1)
+				if err != nil {
+					return Value{}, err
+				}
+				return call.joinTexts(2, delimiter, ignoreEmpty)
+			},
+		},
+		FormulaFunction{
+			Name:        "LEFT",
+			Description: "Returns the first characters of a text.",
+			MinArgs:     1,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "text", Type: ArgumentTypeText},
+				{Name: "count", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				runes, count, err := call.textAndCount()
+				if err != nil {
+					return Value{}, err
+				}
+				return StringValue(string(runes[:count])), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "RIGHT",
+			Description: "Returns the last characters of a text.",
+			MinArgs:     1,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "text", Type: ArgumentTypeText},
+				{Name: "count", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				runes, count, err := call.textAndCount()
+				if err != nil {
+					return Value{}, err
+				}
+				return StringValue(string(runes[len(runes)-count:])), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "MID",
+			Description: "Returns a number of characters of a text starting at a 1-based position.",
+			MinArgs:     3,
+			MaxArgs:     3,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "text", Type: ArgumentTypeText},
+				{Name: "start", Type: ArgumentTypeNumber},
+				{Name: "count", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				start, err := call.Number(1)
+				if err != nil {
+					return Value{}, err
+				}
+				count, err := call.Number(2)
+				if err != nil {
+					return Value{}, err
+				}
+				if start < 1 || count < 0 {
+					return Value{}, newFormulaError(ErrorCodeValue, "MID start must be at least 1 and count not negative")
+				}
+				runes := []rune(text)
+				from := clampCount(start-1, len(runes))
+				to := from + clampCount(count, len(runes)-from)
+				return StringValue(string(runes[from:to])), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "LEN",
+			Description: "Returns the number of characters in a text.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return NumberValue(float64(utf8.RuneCountInString(text))), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "UPPER",
+			Description: "Converts a text to upper case.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return StringValue(strings.ToUpper(text)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "LOWER",
+			Description: "Converts a text to lower case.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				return StringValue(strings.ToLower(text)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "TRIM",
+			Description: "Removes leading and trailing spaces and collapses runs of spaces between words into one.",
+			MinArgs:     1,
+			MaxArgs:     1,
+			Arguments:   []FormulaFunctionArgument{{Name: "text", Type: ArgumentTypeText}},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				var words []string
+				for _, word := range strings.Split(text, " ") {
+					if word != "" {
+						words = append(words, word)
+					}
+				}
+				return StringValue(strings.Join(words, " ")), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "SUBSTITUTE",
+			Description: "Replaces occurrences of a text with another, or only the given occurrence.",
+			MinArgs:     3,
+			MaxArgs:     4,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "text", Type: ArgumentTypeText},
+				{Name: "search", Type: ArgumentTypeText},
+				{Name: "replacement", Type: ArgumentTypeText},
+				{Name: "occurrence", Type: ArgumentTypeNumber},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				var texts [3]string
+				for i := range texts {
+					text, err := call.Text(i)
+					if err != nil {
+						return Value{}, err
+					}
+					texts[i] = text
+				}
+				text, search, replacement := texts[0], texts[1], texts[2]
+				if search == "" {
+					return StringValue(text), nil
+				}
+				if call.Len() < 4 {
+					return StringValue(strings.ReplaceAll(text, search, replacement)), nil
+				}
+				occurrence, err := call.Number(3)
+				if err != nil {
+					return Value{}, err
+				}
+				if occurrence < 1 {
+					return Value{}, newFormulaError(ErrorCodeValue, "SUBSTITUTE occurrence must be at least 1")
+				}
+				position := 0
+				for n := 1; ; n++ {
+					i := strings.Index(text[position:], search)
+					if i < 0 {
+						return StringValue(text), nil
+					}
+					position += i
+					if n == int(occurrence) {
+						return StringValue(text[:position] + replacement + text[position+len(search):]), nil
+					}
+					position += len(search)
+				}
+			},
+		},
+		FormulaFunction{
+			Name:        "TEXT",
+			Description: "Formats a number or date with a format code such as \"#,##0.00\", \"0%\" or \"yyyy-mm-dd\".",
+			MinArgs:     2,
+			MaxArgs:     2,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "value", Type: ArgumentTypeAny},
+				{Name: "format", Type: ArgumentTypeText},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				value, err := call.scalar(0)
+				if err != nil {
+					return Value{}, err
+				}
+				format, err := call.Text(1)
+				if err != nil {
+					return Value{}, err
+				}
+				return StringValue(formatValue(value, format)), nil
+			},
+		},
+		FormulaFunction{
+			Name:        "SPLIT",
+			Description: "Splits a text at each of the delimiter characters, or at the whole delimiter, into a row of values. Use it inside INDEX or other functions taking ranges.",
+			MinArgs:     2,
+			MaxArgs:     4,
+			Arguments: []FormulaFunctionArgument{
+				{Name: "text", Type: ArgumentTypeText},
+				{Name: "delimiter", Type: ArgumentTypeText},
+				{Name: "split_by_each", Type: ArgumentTypeBoolean},
+				{Name: "remove_empty", Type: ArgumentTypeBoolean},
+			},
+			Evaluate: func(call *FunctionCall) (Value, error) {
+				text, err := call.Text(0)
+				if err != nil {
+					return Value{}, err
+				}
+				delimiter, err := call.Text(1)
+				if err != nil {
+					return Value{}, err
+				}
+				if delimiter == "" {
+					return Value{}, newFormulaError(ErrorCodeValue, "SPLIT delimiter must not be empty")
+				}
+				splitByEach, removeEmpty := true, true
+				if call.Len() > 2 && !call.IsEmpty(2) {
+					if splitByEach, err = call.boolean(2); err != nil {
+						return Value{}, err
+					}
+				}
+				if call.Len() > 3 && !call.IsEmpty(3) {
+					if removeEmpty, err = call.boolean(3); err != nil {
+						return Value{}, err
+					}
+				}
+
+				var pieces []string
+				if splitByEach {
+					pieces = strings.FieldsFunc(text, func(r rune) bool { return strings.ContainsRune(delimiter, r) })
+					if !removeEmpty {
+						pieces = strings.Split(text, string([]rune(delimiter)[0]))
+						for _, r := range []rune(delimiter)[1:] {
+							var split []string
+							for _, piece := range pieces {
+								split = append(split, strings.Split(piece, string(r))...)
+							}
+							pieces = split
+						}
+					}
+				} else {
+					pieces = strings.Split(text, delimiter)
+					if removeEmpty {
+						nonEmpty := pieces[:0]
+						for _, piece := range pieces {
+							if piece != "" {
+								nonEmpty = append(nonEmpty, piece)
+							}
+						}
+						pieces = nonEmpty
+					}
+				}
+
+				row := make([]Value, len(pieces))
+				for i, piece := range pieces {
+					row[i] = parseValue(piece)
+				}
+				if len(row) == 0 {
+					row = []Value{EmptyValue()}
+				}
+				return ArrayValue([][]Value{row}), nil
+			},
+		},
+	)
+}
+
+func concat(call *FunctionCall) (Value, error) {
+	return call.joinTexts(0, "", false)
+}
+
+// maxTextLength is the longest text Excel lets a formula return.
+const maxTextLength = 32767
+
+// joinTexts joins the text of every argument from start on with delimiter, with ranges flattened row by row and
+// errors propagating. The positions of a range that were never written are empty texts, which ignoreEmpty leaves
+// out like any other. Only the written cells of a range are walked when its empty texts add nothing, and a result
+// longer than maxTextLength is an error, so joining a whole column does not build a text for every row.
+func (c *FunctionCall) joinTexts(start int, delimiter string, ignoreEmpty bool) (Value, error) {
+	var joined strings.Builder
+	count := 0
+	add := func(v Value) error {
+		if v.Type == ValueTypeError {
+			return v.Error
+		}
+		text := v.String()
+		if ignoreEmpty && text == "" {
+			return nil
+		}
+		if count > 0 {
+			joined.WriteString(delimiter)
+		}
+		count++
+		joined.WriteString(text)
+		if joined.Len() > maxTextLength {
+			return newFormulaError(ErrorCodeValue, "%s result is longer than %d characters", c.Name, maxTextLength)
+		}
+		return nil
+	}
+	keepEmpty := !ignoreEmpty && delimiter != ""
+	for i := start; i < c.Len(); i++ {
+		value, err := c.Argument(i)
+		if err != nil {
+			return Value{}, err
+		}
+		if value.Range == nil {
+			for _, v := range value.Values() {
+				err = add(v)
+				if err != nil {
+					return Value{}, err
+				}
+			}
+			continue
+		}
+		// next is the first position row by row not added yet, the unwritten ones before a cell are empty
+		next := 0
+		addEmptyUntil := func(position int) error {
+			for ; keepEmpty && next < position; next++ {
+				err := add(EmptyValue())
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for _, cell := range value.Range.cells {
+			position := cell.row*value.Range.columnCount + cell.column
+			err = addEmptyUntil(position)
+			if err != nil {
+				return Value{}, err
+			}
+			next = position + 1
+			err = add(cell.value)
+			if err != nil {
+				return Value{}, err
+			}
+		}
+		err = addEmptyUntil(value.Range.rowCount * value.Range.columnCount)
+		if err != nil {
+			return Value{}, err
+		}
+	}
+	return StringValue(joined.String()), nil
+}
+
+// textAndCount reads the text and optional character count arguments of LEFT and RIGHT, count defaults to 1 and
+// is at most the number of characters in the text.
+func (c *FunctionCall) textAndCount() ([]rune, int, error) {
+	text, err := c.Text(0)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := c.optionalNumber(1, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if count < 0 {
+		return nil, 0, newFormulaError(ErrorCodeValue, "%s count must not be negative", c.Name)
+	}
+	runes := []rune(text)
+	return runes, clampCount(count, len(runes)), nil
+}
+
+// clampCount converts a non-negative character count to an int of at most limit, before the conversion so that
+// counts too large for an int do not overflow.
+func clampCount(count float64, limit int) int {
+	if count > float64(limit) {
+		return limit
+	}
+	return int(count)
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTextFunctions(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "Hello", ComputedType: ValueTypeString},
+		{ColumnIndex: 0, RowIndex: 1, ComputedValue: "", ComputedType: ValueTypeEmpty},
+		{ColumnIndex: 0, RowIndex: 2, ComputedValue: "World", ComputedType: ValueTypeString},
+		{ColumnIndex: 1, RowIndex: 0, ComputedValue: "1234.5", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "2024-03-05", ComputedType: ValueTypeDate},
+		{ColumnIndex: 1, RowIndex: 2, ComputedValue: "#N/A", ComputedType: ValueTypeError},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=CONCAT(A1, \" \", A3)", "Hello World"},
+		{"=CONCAT(A1:A3, \"!\")", "HelloWorld!"},
+		{"=CONCATENATE(\"a\", 1, TRUE)", "a1TRUE"},
+		{"=CONCAT(B1:B3)", "#N/A"},
+		{"=TEXTJOIN(\", \", TRUE, A1:A3)", "Hello, World"},
+		{"=TEXTJOIN(\"-\", FALSE, A1:A3)", "Hello--World"},
+		{"=LEFT(A1, 2)", "He"},
+		{"=LEFT(A1)", "H"},
+		{"=LEFT(A1, 10)", "Hello"},
+		{"=LEFT(A1, -1)", "#VALUE!"},
+		{"=RIGHT(\"héllo\", 4)", "éllo"},
+		{"=MID(A1, 2, 3)", "ell"},
+		{"=MID(A1, 4, 10)", "lo"},
+		{"=MID(A1, 0, 1)", "#VALUE!"},
+		{"=LEFT(\"abc\", 1E+19)", "abc"},
+		{"=RIGHT(\"abc\", 1E+300)", "abc"},
+		{"=MID(\"abc\", 1, 1E+300)", "abc"},
+		{"=MID(\"abc\", 2, 1E+19)", "bc"},
+		{"=MID(\"abc\", 1E+300, 1)", ""},
+		{"=LEN(\"héllo\")", "5"},
+		{"=LEN(A2)", "0"},
+		{"=UPPER(A1)", "HELLO"},
+		{"=LOWER(A1)", "hello"},
+		{"=TRIM(\"  a   b  c \")", "a b c"},
+		{"=SUBSTITUTE(\"a-b-c\", \"-\", \"+\")", "a+b+c"},
+		{"=SUBSTITUTE(\"a-b-c\", \"-\", \"+\", 2)", "a-b+c"},
+		{"=SUBSTITUTE(\"a-b-c\", \"-\", \"+\", 3)", "a-b-c"},
+		{"=SUBSTITUTE(\"a-b\", \"-\", \"+\", 0)", "#VALUE!"},
+		{"=TEXT(B1, \"#,##0.00\")", "1,234.50"},
+		{"=TEXT(1234.5, \"0\")", "1235"},
+		{"=TEXT(0.256, \"0%\")", "26%"},
+		{"=TEXT(0.256, \"0.0%\")", "25.6%"},
+		{"=TEXT(12345, \"0.00E+00\")", "1.23E+04"},
+		{"=TEXT(3.1, \"#.##\")", "3.1"},
+		{"=TEXT(5, \"000\")", "005"},
+		{"=TEXT(-5, \"0.00;(0.00)\")", "(5.00)"},
+		{"=TEXT(-5, \"0\")", "-5"},
+		{"=TEXT(-1234.567, \"$#,##0.00\")", "-$1,234.57"},
+		{"=TEXT(-0.001, \"$0.00\")", "$0.00"},
+		{"=TEXT(0, \"0;-0;\"\"zero\"\"\")", "zero"},
+		{"=TEXT(42, \"\"\"$\"\"#,##0\")", "$42"},
+		{"=TEXT(B2, \"yyyy-mm-dd\")", "2024-03-05"},
+		{"=TEXT(B2, \"mmm d, yyyy\")", "Mar 5, 2024"},
+		{"=TEXT(B2, \"dddd, mmmm dd\")", "Tuesday, March 05"},
+		{"=TEXT(\"2024-03-05\", \"dd/mm/yy\")", "05/03/24"},
+		{"=TEXT(45356.75, \"hh:mm AM/PM\")", "06:00 PM"},
+		{"=TEXT(45356.5, \"h:mm:ss\")", "12:00:00"},
+		{"=TEXT(\"abc\", \"0.00\")", "abc"},
+		{"=TEXT(TRUE, \"0\")", "TRUE"},
+		{"=TEXT(1.5, \"General\")", "1.5"},
+		{"=INDEX(SPLIT(\"a,b;c\", \",;\"), 3)", "c"},
+		{"=SUM(SPLIT(\"1,,2\", \",\"))", "3"},
+		{"=INDEX(SPLIT(\"a,,b\", \",\", TRUE, FALSE), 3)", "b"},
+		{"=INDEX(SPLIT(\"a--b-c\", \"--\", FALSE), 2)", "b-c"},
+		{"=SPLIT(\"a\", \"\")", "#VALUE!"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+
+			result, err := c.ComputeValueFromRaw(otherCells)
+
+			if strings.HasPrefix(test.expected, "#") {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package model
+
+import (
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"gorm.io/gorm"
+	"strconv"
+	"strings"
+)
+
+// gridShift describes rows or columns inserted into or deleted from a sheet.
+type gridShift struct {
+	// sheet is the ID of the sheet the rows or columns are inserted into or deleted from
+	sheet   string
+	columns bool
+	// index is the first row or column inserted or deleted
+	index int
+	// count is positive for inserted rows or columns and negative for deleted ones
+	count int
+}
+
+// position returns where a row or column ends up after the shift, false when it is deleted.
+func (s gridShift) position(p int) (int, bool) {
+	if s.count < 0 && p >= s.index && p < s.index-s.count {
+		return p, false
+	}
+	if p >= s.index {
+		return p + s.count, true
+	}
+	return p, true
+}
+
+// bounds returns the rows or columns a range from start to end covers after the shift. A range that loses
+// some of its rows or columns shrinks, one that loses all of them is deleted. An open end stays open.
+func (s gridShift) bounds(start int, end int) (int, int, bool) {
+	newStart, ok := s.position(start)
+	if !ok {
+		// the first row or column left after the deleted ones moves up to the index
+		newStart = s.index
+	}
+	newEnd := end
+	if end != openRangeEnd {
+		if newEnd, ok = s.position(end); !ok {
+			newEnd = s.index - 1
+		}
+	}
+	return newStart, newEnd, newStart <= newEnd
+}
+
+// parseReferenceEnd reads one side of a reference like parseRangeEnd does, keeping its $ anchors. A column or
+// row that is left out is -1.
+func parseReferenceEnd(code string) (cellReference, error) {
+	anchored := strings.HasPrefix(code, "$")
+	switch {
+	case columnCodePattern.MatchString(code):
+		column, _, err := columnAndRowIndexFromCode(code + "1")
+		return cellReference{column: column, row: -1, absoluteColumn: anchored}, err
+	case rowCodePattern.MatchString(code):
+		row, err := strconv.Atoi(strings.TrimPrefix(code, "$"))
+		if err != nil || row < 1 {
+			return cellReference{}, fmt.Errorf("invalid row %s", code)
+		}
+		return cellReference{column: -1, row: row - 1, absoluteRow: anchored}, nil
+	}
+	return parseCellReference(code)
+}
+
+// code returns how a row or column is shown, as 3 or C.
+func (s gridShift) code(p int) string {
+	if s.columns {
+		return columnCodeFromColumnIndex(p)
+	}
+	return strconv.Itoa(p + 1)
+}
+
+// axis returns the row or column of a reference end that the shift moves.
+func (s gridShift) axis(r *cellReference) *int {
+	if s.columns {
+		return &r.column
+	}
+	return &r.row
+}
+
+// shiftReference rewrites a reference within the shifted sheet such as B5, $B$5, B2:B10 or 3:3. Absolute
+// references move as well since the cells they point at move. References to deleted cells become #REF!.
+func (s gridShift) shiftReference(reference string) string {
+	parts := strings.Split(reference, ":")
+	ends := make([]cellReference, len(parts))
+	for i, part := range parts {
+		end, err := parseReferenceEnd(part)
+		if err != nil {
+			return reference
+		}
+		ends[i] = end
+	}
+
+	if len(ends) == 1 {
+		position, ok := s.position(*s.axis(&ends[0]))
+		if !ok {
+			return string(ErrorCodeRef)
+		}
+		*s.axis(&ends[0]) = position
+		return ends[0].String()
+	}
+
+	start, end := s.axis(&ends[0]), s.axis(&ends[1])
+	if *start < 0 {
+		// whole columns are not moved by inserted rows, nor whole rows by inserted columns
+		return reference
+	}
+	last := *end
+	if last < 0 {
+		last = openRangeEnd
+	}
+	newStart, newEnd, ok := s.bounds(*start, last)
+	if !ok {
+		return string(ErrorCodeRef)
+	}
+	*start = newStart
+	if last != openRangeEnd {
+		*end = newEnd
+	}
+	return ends[0].String() + ":" + ends[1].String()
+}
+
+// rewriteFormula rewrites the references of a formula in the sheet with ID formulaSheet that point into the
+// shifted sheet, references to other sheets are kept.
+func (s gridShift) rewriteFormula(rawValue string, formulaSheet string, spreadsheet Spreadsheet) string {
+	if !strings.HasPrefix(rawValue, "=") {
+		return rawValue
+	}
+	return rewriteFormulaReferences(rawValue, func(reference string) string {
+		name, ref := splitSheetReference(reference)
+		sheet := formulaSheet
+		if name != "" {
+			target, ok := spreadsheet.sheetByName(name)
+			if !ok {
+				return reference
+			}
+			sheet = target.id()
+		}
+		if sheet != s.sheet {
+			return reference
+		}
+		shifted := s.shiftReference(ref)
+		if name == "" || shifted == string(ErrorCodeRef) {
+			return shifted
+		}
+		return reference[:len(reference)-len(ref)] + shifted
+	})
+}
+
+// shiftCells moves the cells of the shifted sheet and rewrites every formula that refers to them. It returns the cells as they are after the shift, along with the positions left empty and the cells
+// whose position or formula changed.
+func (s gridShift) shiftCells(cells []Cell, spreadsheet Spreadsheet) (shifted []Cell, emptied []cellKey, changed []cellKey) {
+	occupied := make(map[cellKey]bool)
+	var vacated []cellKey
+	for _, cell := range cells {
+		moved := cell
+		moved.RawValue = s.rewriteFormula(cell.RawValue, cell.SheetID, spreadsheet)
+		if cell.SheetID == s.sheet {
+			position := &moved.RowIndex
+			if s.columns {
+				position = &moved.ColumnIndex
+			}
+			newPosition, ok := s.position(*position)
+			if !ok || newPosition != *position {
+				vacated = append(vacated, keyOf(&cell))
+			}
+			if !ok {
+				continue
+			}
+			*position = newPosition
+		}
+		if moved.RawValue != cell.RawValue || keyOf(&moved) != keyOf(&cell) {
+			changed = append(changed, keyOf(&moved))
+		}
+		occupied[keyOf(&moved)] = true
+		shifted = append(shifted, moved)
+	}
+	for _, key := range vacated {
+		if !occupied[key] {
+			emptied = append(emptied, key)
+		}
+	}
+	sortCellKeys(emptied)
+	return shifted, emptied, changed
+}
+
+// InsertRows inserts count empty rows before index, see shiftGrid.
+func (s *Sheet) InsertRows(context *common.CustomContext, index int, count int) error {
+	return s.shiftGrid(context, false, index, count, false)
+}
+
+// DeleteRows deletes count rows starting at index, see shiftGrid.
+func (s *Sheet) DeleteRows(context *common.CustomContext, index int, count int) error {
+	return s.shiftGrid(context, false, index, count, true)
+}
+
+// InsertColumns inserts count empty columns before index, see shiftGrid.
+func (s *Sheet) InsertColumns(context *common.CustomContext, index int, count int) error {
+	return s.shiftGrid(context, true, index, count, false)
+}
+
+// DeleteColumns deletes count columns starting at index, see shiftGrid.
+func (s *Sheet) DeleteColumns(context *common.CustomContext, index int, count int) error {
+	return s.shiftGrid(context, true, index, count, true)
+}
+
+// shiftGrid inserts count rows or columns before index, or removes them from index on. The cells after them
+// move along and every formula and named range referring to moved cells is rewritten, references to deleted
+// cells become #REF!. Moved cells, the positions they leave empty and all formulas are saved as a new version,
+// so the change can be reverted like any other.
+func (s *Sheet) shiftGrid(context *common.CustomContext, columns bool, index int, count int, remove bool) error {
+	size, unit := s.RowCount, "row"
+	if columns {
+		size, unit = s.ColumnCount, "column"
+	}
+	if count < 1 {
+		return fmt.Errorf("%s count must be at least 1", unit)
+	}
+	if index < 0 || index > size || (remove && index+count > size) {
+		return fmt.Errorf("%s index %d is outside of sheet %s with %d %ss", unit, index, s.Name, size, unit)
+	}
+	if remove && count >= size {
+		return fmt.Errorf("cannot delete every %s of sheet %s", unit, s.Name)
+	}
+	if remove {
+		count = -count
+	}
+	shift := gridShift{sheet: s.id(), columns: columns, index: index, count: count}
+	summary := fmt.Sprintf("Inserted %d %ss before %s %s of %s", count, unit, unit, shift.code(index), s.Name)
+	if remove {
+		summary = fmt.Sprintf("Deleted %d %ss from %s %s of %s", -count, unit, unit, shift.code(index), s.Name)
+	}
+
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		spreadsheet, cells, err := loadCalculation(tx, s.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		version, err = NextVersion(tx, context, s.SpreadsheetID, summary, true)
+		if err != nil {
+			return err
+		}
+		if columns {
+			s.ColumnCount += count
+		} else {
+			s.RowCount += count
+		}
+		err = tx.Save(s).Error
+		if err != nil {
+			return fmt.Errorf("error updating sheet: %v", err)
+		}
+		for i := range spreadsheet.Sheets {
+			if spreadsheet.Sheets[i].ID == s.ID {
+				spreadsheet.Sheets[i] = *s
+			}
+		}
+
+		// names are saved with their sheet, any saved without one refer to the first sheet like in resolveName
+		if len(spreadsheet.Sheets) > 0 {
+			for i := range spreadsheet.NamedRanges {
+				namedRange := &spreadsheet.NamedRanges[i]
+				reference := strings.TrimPrefix(shift.rewriteFormula("="+namedRange.Reference, spreadsheet.Sheets[0].id(), spreadsheet), "=")
+				if reference == namedRange.Reference {
+					continue
+				}
+				namedRange.Reference = reference
+				err = tx.Model(namedRange).Update("reference", reference).Error
+				if err != nil {
+					return fmt.Errorf("error updating named range %s: %v", namedRange.Name, err)
+				}
+			}
+		}
+
+		cells, emptied, changed := shift.shiftCells(cells, spreadsheet)
+		for _, key := range emptied {
+			empty := Cell{SpreadsheetID: s.SpreadsheetID, SheetID: key.sheet, ColumnIndex: key.column, RowIndex: key.row}
+			empty.setValue(EmptyValue())
+			err = empty.saveVersion(tx, version)
+			if err != nil {
+				return fmt.Errorf("error updating cell: %v", err)
+			}
+		}
+
+		// positions moved, so every formula is recalculated, such as =SUM(A:A) that names no moved cell but lost
+		// one with a deleted row
+		for i := range cells {
+			if strings.HasPrefix(cells[i].RawValue, "=") {
+				changed = append(changed, keyOf(&cells[i]))
+			}
+		}
+		// moved cells are among the changed ones, so they are saved at their new position as well
+		_, _, err = saveRecalculation(tx, cells, changed, spreadsheet, version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: s.SpreadsheetID, Version: version})
+	return nil
+}
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGridShiftRewriteFormula(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+	insertRows := gridShift{sheet: "1", index: 2, count: 2}
+	deleteRows := gridShift{sheet: "1", index: 2, count: -2}
+	insertColumns := gridShift{sheet: "1", columns: true, index: 1, count: 1}
+	deleteColumns := gridShift{sheet: "1", columns: true, index: 1, count: -1}
+
+	tests := []struct {
+		name     string
+		shift    gridShift
+		rawValue string
+		expected string
+	}{
+		{"cells above stay", insertRows, "=A1+B2", "=A1+B2"},
+		{"cells below move", insertRows, "=A3*$B$5", "=A5*$B$7"},
+		{"ranges across grow", insertRows, "=SUM(A1:A10)", "=SUM(A1:A12)"},
+		{"ranges below move", insertRows, "=SUM(A3:B4)", "=SUM(A5:B6)"},
+		{"whole columns stay", insertRows, "=SUM(A:A)", "=SUM(A:A)"},
+		{"whole rows move", insertRows, "=SUM(3:4)", "=SUM(5:6)"},
+		{"open ended ranges keep their end", insertRows, "=SUM(B3:B)", "=SUM(B5:B)"},
+		{"text is left alone", insertRows, `="A5"&A5`, `="A5"&A7`},
+		{"deleted cells are #REF!", deleteRows, "=A3+A4+A5", "=#REF!+#REF!+A3"},
+		{"ranges across shrink", deleteRows, "=SUM(A1:A10)", "=SUM(A1:A8)"},
+		{"ranges partly deleted shrink", deleteRows, "=SUM(A4:A6)", "=SUM(A3:A4)"},
+		{"ranges entirely deleted are #REF!", deleteRows, "=SUM(A3:B4)", "=SUM(#REF!)"},
+		{"columns to the right move", insertColumns, "=A1+B1+$C$1", "=A1+C1+$D$1"},
+		{"whole rows stay for columns", insertColumns, "=SUM(2:2)", "=SUM(2:2)"},
+		{"whole columns move", insertColumns, "=SUM(B:C)", "=SUM(C:D)"},
+		{"deleted columns are #REF!", deleteColumns, "=A1+B1+C1", "=A1+#REF!+B1"},
+		{"column ranges shrink", deleteColumns, "=SUM(A1:C1)", "=SUM(A1:B1)"},
+		{"columns past AZ move", insertColumns, "=BA1+SUM(AZ1:ZZ2)+$AAA$1", "=BB1+SUM(BA1:AAA2)+$AAB$1"},
+		{"columns past AZ move back", deleteColumns, "=BA1+SUM(AZ1:ZZ2)", "=AZ1+SUM(AY1:ZY2)"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.shift.rewriteFormula(test.rawValue, "1", spreadsheet))
+		})
+	}
+
+	t.Run("formulas in other sheets only move references to the shifted sheet", func(t *testing.T) {
+		assert.Equal(t, "=Sheet2!A5+A5", insertRows.rewriteFormula("=Sheet2!A5+A5", "2", spreadsheet))
+		assert.Equal(t, "=Sheet1!A7+'Sheet1'!A8", insertRows.rewriteFormula("=Sheet1!A5+'Sheet1'!A6", "2", spreadsheet))
+	})
+}
+
+func TestGridShiftCells(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 0, RowIndex: 0, RawValue: "1"},
+		{SheetID: "1", ColumnIndex: 0, RowIndex: 1, RawValue: "2"},
+		{SheetID: "1", ColumnIndex: 0, RowIndex: 2, RawValue: "3"},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 0, RawValue: "=SUM(A1:A3)"},
+		{SheetID: "2", ColumnIndex: 0, RowIndex: 2, RawValue: "=Sheet1!A3"},
+	}
+
+	t.Run("deleting a row moves the cells below up and empties the last position", func(t *testing.T) {
+		shifted, emptied, changed := gridShift{sheet: "1", index: 1, count: -1}.shiftCells(cells, spreadsheet)
+
+		assert.Equal(t, 4, len(shifted))
+		assert.Equal(t, "3", shifted[1].RawValue)
+		assert.Equal(t, 1, shifted[1].RowIndex)
+		assert.Equal(t, "=SUM(A1:A2)", shifted[2].RawValue)
+		assert.Equal(t, "=Sheet1!A2", shifted[3].RawValue)
+		assert.Equal(t, []cellKey{{sheet: "1", column: 0, row: 2}}, emptied)
+		assert.Equal(t, []cellKey{{sheet: "1", column: 0, row: 1}, {sheet: "1", column: 1, row: 0}, {sheet: "2", column: 0, row: 2}}, changed)
+	})
+
+	t.Run("inserting a row moves the cells below down", func(t *testing.T) {
+		shifted, emptied, _ := gridShift{sheet: "1", index: 0, count: 1}.shiftCells(cells, spreadsheet)
+
+		assert.Equal(t, 5, len(shifted))
+		assert.Equal(t, 1, shifted[0].RowIndex)
+		assert.Equal(t, "=SUM(A2:A4)", shifted[3].RawValue)
+		assert.Equal(t, "=Sheet1!A4", shifted[4].RawValue)
+		assert.Equal(t, []cellKey{{sheet: "1", column: 0, row: 0}, {sheet: "1", column: 1, row: 0}}, emptied)
+	})
+
+	t.Run("recalculates to the same values", func(t *testing.T) {
+		shifted, _, changed := gridShift{sheet: "1", index: 0, count: 1}.shiftCells(cells, spreadsheet)
+		recalculate(shifted, changed, spreadsheet)
+		assert.Equal(t, "6", shifted[3].ComputedValue)
+		assert.Equal(t, "3", shifted[4].ComputedValue)
+	})
+}
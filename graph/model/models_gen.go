@@ -2,29 +2,89 @@
 
 package model
 
+type CellBounds struct {
+	StartRowIndex    int `json:"startRowIndex"`
+	StartColumnIndex int `json:"startColumnIndex"`
+	EndRowIndex      int `json:"endRowIndex"`
+	EndColumnIndex   int `json:"endColumnIndex"`
+}
+
+type CellError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type CellUpdate struct {
+	SheetID         *string `json:"sheetId,omitempty"`
+	ColumnIndex     int     `json:"columnIndex"`
+	RowIndex        int     `json:"rowIndex"`
+	RawValue        string  `json:"rawValue"`
+	ExpectedVersion *string `json:"expectedVersion,omitempty"`
+}
+
 type NewCell struct {
+	SpreadsheetID string  `json:"spreadsheetId"`
+	SheetID       *string `json:"sheetId,omitempty"`
+	RawValue      string  `json:"rawValue"`
+	RowIndex      int     `json:"rowIndex"`
+	ColumnIndex   int     `json:"columnIndex"`
+}
+
+type NewNamedRange struct {
 	SpreadsheetID string `json:"spreadsheetId"`
-	RawValue      string `json:"rawValue"`
-	RowIndex      int    `json:"rowIndex"`
-	ColumnIndex   int    `json:"columnIndex"`
+	Name          string `json:"name"`
+	Reference     string `json:"reference"`
+}
+
+type NewSheet struct {
+	SpreadsheetID  string          `json:"spreadsheetId"`
+	Name           string          `json:"name"`
+	RowCount       int             `json:"rowCount"`
+	ColumnCount    int             `json:"columnCount"`
+	Position       *int            `json:"position,omitempty"`
+	ConflictPolicy *ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 type NewSpreadsheet struct {
-	Name        string `json:"name"`
-	RowCount    int    `json:"rowCount"`
-	ColumnCount int    `json:"columnCount"`
+	Name                 string   `json:"name"`
+	RowCount             int      `json:"rowCount"`
+	ColumnCount          int      `json:"columnCount"`
+	IterativeCalculation *bool    `json:"iterativeCalculation,omitempty"`
+	MaxIterations        *int     `json:"maxIterations,omitempty"`
+	ConvergenceThreshold *float64 `json:"convergenceThreshold,omitempty"`
 }
 
 type UpdateCell struct {
-	RawValue string `json:"rawValue"`
+	RawValue        string  `json:"rawValue"`
+	ExpectedVersion *string `json:"expectedVersion,omitempty"`
 }
 
-type UpdateSpreadsheet struct {
-	Name        *string `json:"name,omitempty"`
-	RowCount    *int    `json:"rowCount,omitempty"`
-	ColumnCount *int    `json:"columnCount,omitempty"`
+type UpdateNamedRange struct {
+	Name      *string `json:"name,omitempty"`
+	Reference *string `json:"reference,omitempty"`
+}
+
+type UpdatePresence struct {
+	SpreadsheetID string  `json:"spreadsheetId"`
+	ClientID      string  `json:"clientId"`
+	Name          *string `json:"name,omitempty"`
+	SheetID       *string `json:"sheetId,omitempty"`
+	Selection     *string `json:"selection,omitempty"`
+}
+
+type UpdateSheet struct {
+	Name           *string         `json:"name,omitempty"`
+	RowCount       *int            `json:"rowCount,omitempty"`
+	ColumnCount    *int            `json:"columnCount,omitempty"`
+	Position       *int            `json:"position,omitempty"`
+	ConflictPolicy *ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
-type Version struct {
-	Version string `json:"version"`
+type UpdateSpreadsheet struct {
+	Name                 *string  `json:"name,omitempty"`
+	RowCount             *int     `json:"rowCount,omitempty"`
+	ColumnCount          *int     `json:"columnCount,omitempty"`
+	IterativeCalculation *bool    `json:"iterativeCalculation,omitempty"`
+	MaxIterations        *int     `json:"maxIterations,omitempty"`
+	ConvergenceThreshold *float64 `json:"convergenceThreshold,omitempty"`
 }
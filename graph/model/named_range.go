@@ -0,0 +1,263 @@
+package model
+
+import (
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"gorm.io/gorm"
+	"regexp"
+	"strings"
+)
+
+// maxNamedRangeLength is the longest name Excel accepts for a named range.
+const maxNamedRangeLength = 255
+
+// NamedRange is a name defined for a cell or range of a spreadsheet, such as TaxRate for Settings!B2, that
+// formulas can use in place of the reference as in =SUM(Revenue)*TaxRate.
+type NamedRange struct {
+	gorm.Model
+	SpreadsheetID string `json:"spreadsheetId"`
+	Name          string `json:"name"`
+	// Reference is the cell or range the name stands for, qualified with its sheet so that moving sheets around
+	// does not change what it refers to.
+	Reference string `json:"reference"`
+}
+
+var namedRangePattern = regexp.MustCompile(`^[A-Za-z_\\][A-Za-z0-9_.]*$`)
+
+// ValidateNamedRangeName checks a name can be told apart from references and values in a formula and is not
+// taken by another name of the spreadsheet.
+func ValidateNamedRangeName(name string, namedRanges []NamedRange, self uint) error {
+	if !namedRangePattern.MatchString(name) {
+		return fmt.Errorf("name %s must start with a letter or underscore and contain only letters, numbers, underscores and periods", name)
+	}
+	if len(name) > maxNamedRangeLength {
+		return fmt.Errorf("name %s is longer than %d characters", name, maxNamedRangeLength)
+	}
+	upper := strings.ToUpper(name)
+	if upper == "TRUE" || upper == "FALSE" || cellReferencePattern.MatchString(upper) {
+		return fmt.Errorf("name %s cannot be used because it reads as a value or cell reference", name)
+	}
+	for _, namedRange := range namedRanges {
+		if namedRange.ID != self && strings.EqualFold(namedRange.Name, name) {
+			return fmt.Errorf("name %s is already defined", name)
+		}
+	}
+	return nil
+}
+
+// normalizeNamedRangeReference checks the reference a name stands for and returns it without a leading =.
+// A reference without a sheet is qualified with the first of sheets.
+func normalizeNamedRangeReference(reference string, sheets []Sheet) (string, error) {
+	reference = strings.TrimPrefix(strings.TrimSpace(reference), "=")
+	sheetName, ref := splitSheetReference(reference)
+	if _, ok := referenceBounds(ref); !ok {
+		return "", fmt.Errorf("invalid reference %s", reference)
+	}
+	if sheetName == "" {
+		if len(sheets) == 0 {
+			return reference, nil
+		}
+		return quoteSheetName(sheets[0].Name) + "!" + reference, nil
+	}
+	if _, ok := (Spreadsheet{Sheets: sheets}).sheetByName(sheetName); !ok {
+		return "", fmt.Errorf("unknown sheet %s", sheetName)
+	}
+	return reference, nil
+}
+
+// LoadNamedRanges returns the names defined in a spreadsheet.
+func LoadNamedRanges(db *gorm.DB, spreadsheetID string) ([]NamedRange, error) {
+	var namedRanges []NamedRange
+	err := db.Where("spreadsheet_id = ?", spreadsheetID).Order("name").Find(&namedRanges).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting named ranges: %v", err)
+	}
+	return namedRanges, nil
+}
+
+// resolveName returns the reference a name used in a formula stands for. Names are saved with their sheet, one
+// that was saved without is qualified with the first sheet. References to cells and ranges are not names.
+func (s Spreadsheet) resolveName(reference string) (string, bool) {
+	if strings.ContainsAny(reference, "!:") {
+		return "", false
+	}
+	if _, _, err := columnAndRowIndexFromCode(reference); err == nil {
+		return "", false
+	}
+	for _, namedRange := range s.NamedRanges {
+		if !strings.EqualFold(namedRange.Name, reference) {
+			continue
+		}
+		// a name whose cells were deleted stays #REF! until it is pointed elsewhere
+		if namedRange.Reference == string(ErrorCodeRef) {
+			return namedRange.Reference, true
+		}
+		if sheetName, _ := splitSheetReference(namedRange.Reference); sheetName == "" && len(s.Sheets) > 0 {
+			return quoteSheetName(s.Sheets[0].Name) + "!" + namedRange.Reference, true
+		}
+		return namedRange.Reference, true
+	}
+	return "", false
+}
+
+// usesName reports whether the formula of c uses any of the names.
+func usesName(c *Cell, names []string) bool {
+	if len(c.RawValue) == 0 || c.RawValue[0] != '=' {
+		return false
+	}
+	formula, err := c.parseFormula()
+	if err != nil {
+		return false
+	}
+	for _, reference := range formulaReferences(formula) {
+		for _, name := range names {
+			if strings.EqualFold(reference, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// recalculateNameUsers recalculates every formula using one of the names after they were defined, changed or
+// deleted, and saves the recalculated cells under version.
+func recalculateNameUsers(tx *gorm.DB, spreadsheetID string, version uint64, names ...string) error {
+	spreadsheet, cells, err := loadCalculation(tx, spreadsheetID)
+	if err != nil {
+		return err
+	}
+	var changed []cellKey
+	for i := range cells {
+		if usesName(&cells[i], names) {
+			changed = append(changed, keyOf(&cells[i]))
+		}
+	}
+	_, _, err = saveRecalculation(tx, cells, changed, spreadsheet, version)
+	return err
+}
+
+// CreateNamedRange defines a name in its spreadsheet, formulas that already use the name are recalculated.
+func CreateNamedRange(context *common.CustomContext, namedRange *NamedRange) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		namedRanges, err := LoadNamedRanges(tx, namedRange.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		err = ValidateNamedRangeName(namedRange.Name, namedRanges, 0)
+		if err != nil {
+			return err
+		}
+		sheets, err := LoadSheets(tx, namedRange.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		namedRange.Reference, err = normalizeNamedRangeReference(namedRange.Reference, sheets)
+		if err != nil {
+			return err
+		}
+		version, err = NextVersion(tx, context, namedRange.SpreadsheetID, fmt.Sprintf("Defined name %s as %s", namedRange.Name, namedRange.Reference), true)
+		if err != nil {
+			return err
+		}
+		err = tx.Create(namedRange).Error
+		if err != nil {
+			return fmt.Errorf("error creating named range: %v", err)
+		}
+		return recalculateNameUsers(tx, namedRange.SpreadsheetID, version, namedRange.Name)
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: namedRange.SpreadsheetID, Version: version})
+	return nil
+}
+
+// Update renames a name or points it at another reference, every formula using the old or new name is recalculated.
+func (n *NamedRange) Update(context *common.CustomContext, input UpdateNamedRange) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		previousName := n.Name
+		if input.Name != nil {
+			namedRanges, err := LoadNamedRanges(tx, n.SpreadsheetID)
+			if err != nil {
+				return err
+			}
+			err = ValidateNamedRangeName(*input.Name, namedRanges, n.ID)
+			if err != nil {
+				return err
+			}
+			n.Name = *input.Name
+		}
+		if input.Reference != nil {
+			sheets, err := LoadSheets(tx, n.SpreadsheetID)
+			if err != nil {
+				return err
+			}
+			n.Reference, err = normalizeNamedRangeReference(*input.Reference, sheets)
+			if err != nil {
+				return err
+			}
+		}
+		summary := fmt.Sprintf("Defined name %s as %s", n.Name, n.Reference)
+		if n.Name != previousName {
+			summary = fmt.Sprintf("Renamed name %s to %s, defined as %s", previousName, n.Name, n.Reference)
+		}
+		var err error
+		version, err = NextVersion(tx, context, n.SpreadsheetID, summary, true)
+		if err != nil {
+			return err
+		}
+		err = tx.Save(n).Error
+		if err != nil {
+			return fmt.Errorf("error updating named range: %v", err)
+		}
+		return recalculateNameUsers(tx, n.SpreadsheetID, version, previousName, n.Name)
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: n.SpreadsheetID, Version: version})
+	return nil
+}
+
+// Delete removes a name, formulas that used it are recalculated to #NAME?.
+func (n *NamedRange) Delete(context *common.CustomContext) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		var err error
+		version, err = NextVersion(tx, context, n.SpreadsheetID, fmt.Sprintf("Deleted name %s", n.Name), true)
+		if err != nil {
+			return err
+		}
+		err = tx.Delete(n).Error
+		if err != nil {
+			return fmt.Errorf("error deleting named range: %v", err)
+		}
+		return recalculateNameUsers(tx, n.SpreadsheetID, version, n.Name)
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: n.SpreadsheetID, Version: version})
+	return nil
+}
+
+// renameNamedRangeSheets points the names that refer to a renamed sheet at its new name.
+func renameNamedRangeSheets(tx *gorm.DB, spreadsheetID string, from string, to string) error {
+	namedRanges, err := LoadNamedRanges(tx, spreadsheetID)
+	if err != nil {
+		return err
+	}
+	for i := range namedRanges {
+		reference := strings.TrimPrefix(renameSheetReferences("="+namedRanges[i].Reference, from, to), "=")
+		if reference == namedRanges[i].Reference {
+			continue
+		}
+		err = tx.Model(&namedRanges[i]).Update("reference", reference).Error
+		if err != nil {
+			return fmt.Errorf("error updating named range %s: %v", namedRanges[i].Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func testNamedRangeSpreadsheet() Spreadsheet {
+	return Spreadsheet{
+		Sheets: testSheets(),
+		NamedRanges: []NamedRange{
+			{Name: "TaxRate", Reference: "Sheet2!B2"},
+			{Name: "Revenue", Reference: "B2:B100"},
+			{Name: "Budget", Reference: "'Q1 Budget'!B:B"},
+			{Name: "Missing", Reference: "Sheet9!A1"},
+		},
+	}
+}
+
+func TestNamedRanges(t *testing.T) {
+	spreadsheet := testNamedRangeSpreadsheet()
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "100", ComputedValue: "100", ComputedType: ValueTypeNumber},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 2, RawValue: "200", ComputedValue: "200", ComputedType: ValueTypeNumber},
+		{SheetID: "2", ColumnIndex: 1, RowIndex: 1, RawValue: "0.5", ComputedValue: "0.5", ComputedType: ValueTypeNumber},
+		{SheetID: "3", ColumnIndex: 1, RowIndex: 0, RawValue: "7", ComputedValue: "7", ComputedType: ValueTypeNumber},
+	}
+
+	tests := []struct {
+		rawValue string
+		expected string
+	}{
+		{"=SUM(Revenue)*TaxRate", "150"},
+		{"=sum(revenue)", "300"},
+		{"=COUNT(Revenue)", "2"},
+		{"=SUM(Budget)", "7"},
+		{"=Missing", "#REF!"},
+		{"=Undefined", "#NAME?"},
+	}
+	for _, test := range tests {
+		t.Run(test.rawValue, func(t *testing.T) {
+			// the formula is on another sheet, names without a sheet still refer to the first one
+			c := Cell{SheetID: "2", ColumnIndex: 4, RowIndex: 4, RawValue: test.rawValue}
+			assert.Equal(t, test.expected, c.computeValue(cells, spreadsheet).String())
+		})
+	}
+
+	t.Run("names keep their sheet when sheets are moved", func(t *testing.T) {
+		reference, err := normalizeNamedRangeReference("B2:B3", spreadsheet.Sheets)
+		assert.NoError(t, err)
+		moved := Spreadsheet{
+			Sheets:      []Sheet{spreadsheet.Sheets[1], spreadsheet.Sheets[0], spreadsheet.Sheets[2]},
+			NamedRanges: []NamedRange{{Name: "Pinned", Reference: reference}},
+		}
+		c := Cell{SheetID: "2", ColumnIndex: 4, RowIndex: 4, RawValue: "=SUM(Pinned)"}
+		assert.Equal(t, "300", c.computeValue(cells, moved).String())
+	})
+}
+
+func TestNamedRangeDependents(t *testing.T) {
+	spreadsheet := testNamedRangeSpreadsheet()
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 3, RowIndex: 0, RawValue: "=SUM(Revenue)*TaxRate"},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "100"},
+		{SheetID: "2", ColumnIndex: 1, RowIndex: 1, RawValue: "0.5"},
+	}
+	graph := buildDependencyGraph(cells, spreadsheet)
+
+	assert.Equal(t, []cellKey{keyOf(&cells[0])}, graph.directDependents(keyOf(&cells[1])))
+	assert.Equal(t, []cellKey{keyOf(&cells[0])}, graph.directDependents(keyOf(&cells[2])))
+	assert.Equal(t, []cellKey{keyOf(&cells[0])}, graph.directDependents(cellKey{sheet: "1", column: 1, row: 50}))
+	assert.Empty(t, graph.directDependents(cellKey{sheet: "2", column: 1, row: 50}))
+
+	assert.True(t, usesName(&cells[0], []string{"taxrate"}))
+	assert.False(t, usesName(&cells[0], []string{"Budget"}))
+}
+
+func TestValidateNamedRangeName(t *testing.T) {
+	namedRanges := testNamedRangeSpreadsheet().NamedRanges
+	namedRanges[0].ID = 1
+	assert.NoError(t, ValidateNamedRangeName("Profit", namedRanges, 0))
+	assert.NoError(t, ValidateNamedRangeName("_tax.rate2", namedRanges, 0))
+	assert.NoError(t, ValidateNamedRangeName("taxrate", namedRanges, 1))
+	assert.Error(t, ValidateNamedRangeName("taxrate", namedRanges, 0))
+	assert.Error(t, ValidateNamedRangeName("B2", namedRanges, 0))
+	assert.Error(t, ValidateNamedRangeName("true", namedRanges, 0))
+	assert.Error(t, ValidateNamedRangeName("2020Sales", namedRanges, 0))
+	assert.Error(t, ValidateNamedRangeName("Net Sales", namedRanges, 0))
+}
+
+func TestNormalizeNamedRangeReference(t *testing.T) {
+	sheets := testSheets()
+	reference, err := normalizeNamedRangeReference("='Q1 Budget'!$B$2", sheets)
+	assert.NoError(t, err)
+	assert.Equal(t, "'Q1 Budget'!$B$2", reference)
+
+	// the sheet is kept with the name so that moving sheets does not change what it refers to
+	reference, err = normalizeNamedRangeReference("B2:B10", sheets)
+	assert.NoError(t, err)
+	assert.Equal(t, "Sheet1!B2:B10", reference)
+	sheets[0], sheets[2] = sheets[2], sheets[0]
+	reference, err = normalizeNamedRangeReference("A1", sheets)
+	assert.NoError(t, err)
+	assert.Equal(t, "'Q1 Budget'!A1", reference)
+
+	_, err = normalizeNamedRangeReference("Sheet9!A1", sheets)
+	assert.Error(t, err)
+	_, err = normalizeNamedRangeReference("Revenue", sheets)
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// PresenceTimeout is how long a collaborator stays present without refreshing their presence. The presence
+// subscription refreshes it every keepalive interval, so collaborators whose instance went away without
+// removing them expire after missing two refreshes.
+const PresenceTimeout = 2 * common.KeepAliveInterval
+
+// Presence is a collaborator viewing a spreadsheet and what they have selected in it. Presences are kept in
+// the database so that every instance sees the collaborators connected to the others.
+type Presence struct {
+	SpreadsheetID string `json:"spreadsheetId" gorm:"primaryKey"`
+	// ClientID identifies the collaborator, it is chosen by the client.
+	ClientID string  `json:"clientId" gorm:"primaryKey"`
+	Name     *string `json:"name"`
+	SheetID  *string `json:"sheetId"`
+	// Selection is the selected cell or range, such as B2 or B2:D10, within SheetID.
+	Selection *string   `json:"selection"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// LoadPresences returns the collaborators present in a spreadsheet, ordered by client ID.
+func LoadPresences(db *gorm.DB, spreadsheetID string) ([]*Presence, error) {
+	var presences []*Presence
+	err := db.Where("spreadsheet_id = ? AND updated_at > ?", spreadsheetID, time.Now().Add(-PresenceTimeout)).Order("client_id").Find(&presences).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting presences: %v", err)
+	}
+	return presences, nil
+}
+
+// SamePresences reports whether two lists of presences show the same collaborators and selections, ignoring
+// when they were refreshed.
+func SamePresences(a []*Presence, b []*Presence) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	equal := func(x *string, y *string) bool {
+		return (x == nil && y == nil) || (x != nil && y != nil && *x == *y)
+	}
+	for i := range a {
+		if a[i].ClientID != b[i].ClientID || !equal(a[i].Name, b[i].Name) || !equal(a[i].SheetID, b[i].SheetID) || !equal(a[i].Selection, b[i].Selection) {
+			return false
+		}
+	}
+	return true
+}
+
+// SavePresence marks a collaborator present in a spreadsheet and changes the name, sheet and selection that
+// are given. The other collaborators are told about it.
+func SavePresence(context *common.CustomContext, input UpdatePresence) (*Presence, error) {
+	if input.Selection != nil && *input.Selection != "" {
+		if _, ok := referenceBounds(*input.Selection); !ok {
+			return nil, fmt.Errorf("invalid selection %s", *input.Selection)
+		}
+	}
+	presence := Presence{SpreadsheetID: input.SpreadsheetID, ClientID: input.ClientID}
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Limit(1).Find(&presence).Error
+		if err != nil {
+			return fmt.Errorf("error getting presence: %v", err)
+		}
+		if input.Name != nil {
+			presence.Name = input.Name
+		}
+		if input.SheetID != nil {
+			presence.SheetID = input.SheetID
+		}
+		if input.Selection != nil {
+			presence.Selection = input.Selection
+		}
+		presence.UpdatedAt = time.Now()
+		err = tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&presence).Error
+		if err != nil {
+			return fmt.Errorf("error updating presence: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: presence.SpreadsheetID, Presence: true})
+	return &presence, nil
+}
+
+// RefreshPresence keeps a collaborator present for another PresenceTimeout without changing their selection.
+func RefreshPresence(db *gorm.DB, spreadsheetID string, clientID string) error {
+	err := db.Model(&Presence{}).Where("spreadsheet_id = ? AND client_id = ?", spreadsheetID, clientID).Update("updated_at", time.Now()).Error
+	if err != nil {
+		return fmt.Errorf("error refreshing presence: %v", err)
+	}
+	return nil
+}
+
+// LeavePresence removes a collaborator from a spreadsheet, the other collaborators are told about it.
+func LeavePresence(context *common.CustomContext, spreadsheetID string, clientID string) error {
+	err := context.Database.Where("spreadsheet_id = ? AND client_id = ?", spreadsheetID, clientID).Delete(&Presence{}).Error
+	if err != nil {
+		return fmt.Errorf("error removing presence: %v", err)
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: spreadsheetID, Presence: true})
+	return nil
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestSamePresences(t *testing.T) {
+	b2, d4 := "B2", "D4"
+	presences := []*Presence{{ClientID: "a", Selection: &b2}, {ClientID: "b"}}
+
+	assert.True(t, SamePresences(presences, []*Presence{{ClientID: "a", Selection: &b2, UpdatedAt: time.Now()}, {ClientID: "b"}}))
+	assert.False(t, SamePresences(presences, []*Presence{{ClientID: "a", Selection: &d4}, {ClientID: "b"}}))
+	assert.False(t, SamePresences(presences, []*Presence{{ClientID: "a"}, {ClientID: "b"}}))
+	assert.False(t, SamePresences(presences, presences[:1]))
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// viewportBounds checks bounds lie within a sheet, clamping open ends and ends past the edge of the sheet to
+// its last row or column.
+func viewportBounds(sheet Sheet, bounds cellRange) (cellRange, error) {
+	if bounds.startRow < 0 || bounds.startColumn < 0 {
+		return cellRange{}, fmt.Errorf("row and column indexes must not be negative")
+	}
+	if bounds.endRow < bounds.startRow || bounds.endColumn < bounds.startColumn {
+		return cellRange{}, fmt.Errorf("range ends before it starts")
+	}
+	if bounds.startRow >= sheet.RowCount || bounds.startColumn >= sheet.ColumnCount {
+		return cellRange{}, fmt.Errorf("range starts outside of sheet %s with %d rows and %d columns", sheet.Name, sheet.RowCount, sheet.ColumnCount)
+	}
+	if bounds.endRow >= sheet.RowCount {
+		bounds.endRow = sheet.RowCount - 1
+	}
+	if bounds.endColumn >= sheet.ColumnCount {
+		bounds.endColumn = sheet.ColumnCount - 1
+	}
+	bounds.sheet = sheet.id()
+	return bounds, nil
+}
+
+// cellsInBounds returns the latest version of every cell of a sheet within bounds, ordered by row and column.
+// Only the rows in bounds are read, through the cells_sheet_position index, rather than every cell of the
+// spreadsheet like latestCells.
+func cellsInBounds(db *gorm.DB, sheet Sheet, bounds cellRange) ([]*Cell, error) {
+	bounds, err := viewportBounds(sheet, bounds)
+	if err != nil {
+		return nil, err
+	}
+	var cells []*Cell
+	err = db.Select("DISTINCT ON (row_index, column_index) *").
+		Where("sheet_id = ? AND row_index BETWEEN ? AND ? AND column_index BETWEEN ? AND ?", sheet.ID, bounds.startRow, bounds.endRow, bounds.startColumn, bounds.endColumn).
+		Order("row_index, column_index, version desc").Find(&cells).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting cells: %v", err)
+	}
+	return cells, nil
+}
+
+// CellsInBounds returns the cells of a sheet from startRowIndex and startColumnIndex to endRowIndex and
+// endColumnIndex inclusive, such as the visible part of a large sheet. sheetID defaults to the first sheet.
+func CellsInBounds(db *gorm.DB, spreadsheetID string, sheetID string, bounds CellBounds) ([]*Cell, error) {
+	sheet, err := FindSheet(db, spreadsheetID, sheetID)
+	if err != nil {
+		return nil, err
+	}
+	return cellsInBounds(db, sheet, cellRange{startColumn: bounds.StartColumnIndex, startRow: bounds.StartRowIndex, endColumn: bounds.EndColumnIndex, endRow: bounds.EndRowIndex})
+}
+
+// CellsInRange returns the cells of an A1 style range such as B2:F200, A:A or 'Q1 Budget'!B2:B10. A range
+// without a sheet name is in the sheet with ID sheetID, or the first sheet.
+func CellsInRange(db *gorm.DB, spreadsheetID string, sheetID string, reference string) ([]*Cell, error) {
+	sheetName, ref := splitSheetReference(strings.TrimSpace(reference))
+	bounds, ok := referenceBounds(ref)
+	if !ok {
+		return nil, fmt.Errorf("invalid range %s", reference)
+	}
+	var sheet Sheet
+	if sheetName != "" {
+		sheets, err := LoadSheets(db, spreadsheetID)
+		if err != nil {
+			return nil, err
+		}
+		sheet, ok = (Spreadsheet{Sheets: sheets}).sheetByName(sheetName)
+		if !ok {
+			return nil, fmt.Errorf("unknown sheet %s", sheetName)
+		}
+	} else {
+		var err error
+		sheet, err = FindSheet(db, spreadsheetID, sheetID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cellsInBounds(db, sheet, bounds)
+}
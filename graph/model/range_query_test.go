@@ -0,0 +1,26 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestViewportBounds(t *testing.T) {
+	sheet := testSheets()[2]
+
+	bounds, err := viewportBounds(sheet, cellRange{startColumn: 1, startRow: 1, endColumn: 5, endRow: 200})
+	assert.NoError(t, err)
+	assert.Equal(t, cellRange{sheet: "3", startColumn: 1, startRow: 1, endColumn: 1, endRow: 3}, bounds)
+
+	whole, _ := referenceBounds("A:A")
+	bounds, err = viewportBounds(sheet, whole)
+	assert.NoError(t, err)
+	assert.Equal(t, cellRange{sheet: "3", startColumn: 0, startRow: 0, endColumn: 0, endRow: 3}, bounds)
+
+	_, err = viewportBounds(sheet, cellRange{startColumn: 0, startRow: 4, endColumn: 1, endRow: 5})
+	assert.Error(t, err)
+	_, err = viewportBounds(sheet, cellRange{startColumn: 1, startRow: 2, endColumn: 0, endRow: 3})
+	assert.Error(t, err)
+	_, err = viewportBounds(sheet, cellRange{startColumn: -1, startRow: 0, endColumn: 0, endRow: 0})
+	assert.Error(t, err)
+}
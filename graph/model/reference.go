@@ -0,0 +1,163 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cellReference is a single cell reference such as B2, $B$2, B$2 or $B2. A $ anchors the column or row
+// so that it is kept when a formula is copied to another cell.
+type cellReference struct {
+	column         int
+	row            int
+	absoluteColumn bool
+	absoluteRow    bool
+}
+
+var cellReferencePattern = regexp.MustCompile(`^(\$?)([A-Z]+)(\$?)(\d+)$`)
+
+func parseCellReference(code string) (cellReference, error) {
+	matches := cellReferencePattern.FindStringSubmatch(code)
+	if len(matches) != 5 {
+		return cellReference{}, fmt.Errorf("invalid code format: %s", code)
+	}
+	column, row, err := columnAndRowIndexFromCode(code)
+	if err != nil {
+		return cellReference{}, err
+	}
+	return cellReference{column: column, row: row, absoluteColumn: matches[1] == "$", absoluteRow: matches[3] == "$"}, nil
+}
+
+// String returns the code of the reference, a column or row of -1 is left out as in the B of B2:B.
+func (r cellReference) String() string {
+	var code strings.Builder
+	if r.column >= 0 {
+		if r.absoluteColumn {
+			code.WriteString("$")
+		}
+		code.WriteString(columnCodeFromColumnIndex(r.column))
+	}
+	if r.row >= 0 {
+		if r.absoluteRow {
+			code.WriteString("$")
+		}
+		code.WriteString(strconv.Itoa(r.row + 1))
+	}
+	return code.String()
+}
+
+// offset moves the relative parts of the reference by a number of rows and columns, it returns false when
+// the reference would end up before the first row or column.
+func (r cellReference) offset(rows int, columns int) (cellReference, bool) {
+	if !r.absoluteRow {
+		r.row += rows
+	}
+	if !r.absoluteColumn {
+		r.column += columns
+	}
+	return r, r.row >= 0 && r.column >= 0
+}
+
+// formulaReferencePattern finds cell and range references in the text of a formula, including whole
+// column (B:B), whole row (2:2) and open ended (B2:B) ranges.
+// A reference to another sheet starts with its name, quoted when it is not a plain word as in 'Q1 Budget'!B2.
+var formulaReferencePattern = regexp.MustCompile(`(?:(?:'(?:[^']|'')+'|[A-Za-z_][A-Za-z0-9_.]*)!)?(?:\$?[A-Z]+\$?\d+(:\$?[A-Z]*\$?\d*)?|\$?[A-Z]+:\$?[A-Z]+|\$?\d+:\$?\d+)`)
+
+// formulaTextPattern finds text literals in a formula, "" inside them is an escaped quote.
+var formulaTextPattern = regexp.MustCompile(`"(?:[^"]|"")*"?`)
+
+// rewriteFormulaReferences replaces every cell or range reference in a formula with the result of rewrite.
+// Text literals are left alone, as are names that only look like references such as LOG10(.
+func rewriteFormulaReferences(formula string, rewrite func(reference string) string) string {
+	var rewritten strings.Builder
+	rewriteSegment := func(segment string) {
+		last := 0
+		for _, match := range formulaReferencePattern.FindAllStringIndex(segment, -1) {
+			if !isReferenceBoundary(segment, match[0]-1) || !isReferenceBoundary(segment, match[1]) || (match[1] < len(segment) && segment[match[1]] == '(') {
+				continue
+			}
+			rewritten.WriteString(segment[last:match[0]])
+			rewritten.WriteString(rewrite(segment[match[0]:match[1]]))
+			last = match[1]
+		}
+		rewritten.WriteString(segment[last:])
+	}
+	last := 0
+	for _, text := range formulaTextPattern.FindAllStringIndex(formula, -1) {
+		rewriteSegment(formula[last:text[0]])
+		rewritten.WriteString(formula[text[0]:text[1]])
+		last = text[1]
+	}
+	rewriteSegment(formula[last:])
+	return rewritten.String()
+}
+
+// isReferenceBoundary reports whether the character at i cannot be part of a longer name around a reference.
+func isReferenceBoundary(text string, i int) bool {
+	if i < 0 || i >= len(text) {
+		return true
+	}
+	char := text[i]
+	return !(char >= 'A' && char <= 'Z' || char >= 'a' && char <= 'z' || char >= '0' && char <= '9' || char == '_' || char == '.' || char == '$')
+}
+
+// shiftFormula returns the formula of a cell copied a number of rows and columns away. Relative references
+// move along while $ anchored columns and rows stay, references moved off the sheet become #REF!.
+func shiftFormula(rawValue string, rows int, columns int) string {
+	if !strings.HasPrefix(rawValue, "=") || (rows == 0 && columns == 0) {
+		return rawValue
+	}
+	return rewriteFormulaReferences(rawValue, func(reference string) string {
+		prefix := ""
+		if i := strings.LastIndex(reference, "!"); i >= 0 {
+			prefix, reference = reference[:i+1], reference[i+1:]
+		}
+		parts := strings.Split(reference, ":")
+		for i, part := range parts {
+			shifted, ok := shiftRangeEnd(part, rows, columns)
+			if !ok {
+				return string(ErrorCodeRef)
+			}
+			parts[i] = shifted
+		}
+		return prefix + strings.Join(parts, ":")
+	})
+}
+
+// shiftRangeEnd moves a cell code, a column code such as B or a row number such as 3 like shiftFormula does.
+func shiftRangeEnd(code string, rows int, columns int) (string, bool) {
+	if ref, err := parseCellReference(code); err == nil {
+		shifted, ok := ref.offset(rows, columns)
+		return shifted.String(), ok
+	}
+	anchored := strings.HasPrefix(code, "$")
+	switch {
+	case columnCodePattern.MatchString(code):
+		if anchored {
+			return code, true
+		}
+		column, _, err := columnAndRowIndexFromCode(code + "1")
+		if err != nil || column+columns < 0 {
+			return code, false
+		}
+		return columnCodeFromColumnIndex(column + columns), true
+	case rowCodePattern.MatchString(code):
+		if anchored {
+			return code, true
+		}
+		row, err := strconv.Atoi(code)
+		if err != nil || row+rows < 1 {
+			return code, false
+		}
+		return strconv.Itoa(row + rows), true
+	}
+	return code, false
+}
+
+// CopiedRawValue returns the raw value c would have when copied or filled to another position, with
+// relative references adjusted to the new position.
+func (c *Cell) CopiedRawValue(columnIndex int, rowIndex int) string {
+	return shiftFormula(c.RawValue, rowIndex-c.RowIndex, columnIndex-c.ColumnIndex)
+}
@@ -0,0 +1,114 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseCellReference(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected cellReference
+	}{
+		{"B2", cellReference{column: 1, row: 1}},
+		{"$B$2", cellReference{column: 1, row: 1, absoluteColumn: true, absoluteRow: true}},
+		{"B$2", cellReference{column: 1, row: 1, absoluteRow: true}},
+		{"$B2", cellReference{column: 1, row: 1, absoluteColumn: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.code, func(t *testing.T) {
+			reference, err := parseCellReference(test.code)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, reference)
+			assert.Equal(t, test.code, reference.String())
+		})
+	}
+
+	for _, code := range []string{"", "$", "B$", "$$B2", "B2$", "b2"} {
+		_, err := parseCellReference(code)
+		assert.Error(t, err, code)
+	}
+}
+
+func TestShiftFormula(t *testing.T) {
+	tests := []struct {
+		rawValue string
+		rows     int
+		columns  int
+		expected string
+	}{
+		{"=$A$1*B2", 1, 0, "=$A$1*B3"},
+		{"=$A$1*B2", 0, 2, "=$A$1*D2"},
+		{"=A$1+$A1", 3, 1, "=B$1+$A4"},
+		{"=SUM(B2:B5)", 1, 1, "=SUM(C3:C6)"},
+		{"=SUM($B$2:B5)", 2, 0, "=SUM($B$2:B7)"},
+		{"=B2&\"B2\"", 1, 0, "=B3&\"B2\""},
+		{"=\"say \"\"B2\"\"\"&B2", 1, 0, "=\"say \"\"B2\"\"\"&B3"},
+		{"=LOG10(B2)", 1, 0, "=LOG10(B3)"},
+		{"=B2-1", -1, 0, "=B1-1"},
+		{"=B2-1", -2, 0, "=#REF!-1"},
+		{"=SUM(A1:B2)", 0, -1, "=SUM(#REF!)"},
+		{"=SUM(B:B)", 5, 1, "=SUM(C:C)"},
+		{"=SUM($B:B)", 0, 1, "=SUM($B:C)"},
+		{"=SUM(B2:B)", 1, 0, "=SUM(B3:B)"},
+		{"=COUNT(2:$3)", 1, 4, "=COUNT(3:$3)"},
+		{"=SUM(A:A)", 0, -1, "=SUM(#REF!)"},
+		{"=BA1+AZ1", 1, 0, "=BA2+AZ2"},
+		{"=SUM(AZ1:ZZ1)", 0, 1, "=SUM(BA1:AAA1)"},
+		{"B2", 1, 1, "B2"},
+	}
+	for _, test := range tests {
+		t.Run(test.rawValue, func(t *testing.T) {
+			assert.Equal(t, test.expected, shiftFormula(test.rawValue, test.rows, test.columns))
+		})
+	}
+
+	t.Run("a copied cell keeps its anchors", func(t *testing.T) {
+		c := &Cell{RawValue: "=$A$1*B2", ColumnIndex: 2, RowIndex: 1}
+		assert.Equal(t, "=$A$1*B3", c.CopiedRawValue(2, 2))
+	})
+
+	t.Run("a copied cell past column AZ keeps its column", func(t *testing.T) {
+		c := &Cell{RawValue: "=BA1", ColumnIndex: 53, RowIndex: 0}
+		assert.Equal(t, "=BA2", c.CopiedRawValue(53, 1))
+	})
+}
+
+func TestAbsoluteReferences(t *testing.T) {
+	otherCells := []Cell{
+		{ColumnIndex: 0, RowIndex: 0, ComputedValue: "2", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 1, ComputedValue: "5", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 1, RowIndex: 2, ComputedValue: "7", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 26, RowIndex: 0, ComputedValue: "100", ComputedType: ValueTypeNumber},
+		{ColumnIndex: 52, RowIndex: 0, ComputedValue: "3", ComputedType: ValueTypeNumber},
+	}
+
+	tests := []struct {
+		formula  string
+		expected string
+	}{
+		{"=$A$1*B2", "10"},
+		{"=A$1+$B2", "7"},
+		{"=SUM($B$2:B$3)", "12"},
+		{"=$A1:$B3", "#VALUE!"},
+		{"=$BA$1+BA1", "6"},
+	}
+	for _, test := range tests {
+		t.Run(test.formula, func(t *testing.T) {
+			c := &Cell{RawValue: test.formula}
+			result, _ := c.ComputeValueFromRaw(otherCells)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+
+	t.Run("anchored references are dependencies", func(t *testing.T) {
+		cells := []Cell{
+			{ColumnIndex: 0, RowIndex: 0, RawValue: "2"},
+			{ColumnIndex: 1, RowIndex: 0, RawValue: "=$A$1*2"},
+			{ColumnIndex: 2, RowIndex: 0, RawValue: "=SUM($A1:A$1)"},
+		}
+		dependents, err := cells[0].FindDependentCells(cells)
+		assert.NoError(t, err)
+		assert.Len(t, dependents, 2)
+	})
+}
@@ -0,0 +1,488 @@
+package model
+
+import (
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"gorm.io/gorm"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultSheetName is the name of the sheet every new spreadsheet starts with.
+const DefaultSheetName = "Sheet1"
+
+// maxSheetNameLength is the longest sheet name Excel accepts.
+const maxSheetNameLength = 31
+
+// Sheet is a tab of a spreadsheet with its own grid of cells.
+type Sheet struct {
+	gorm.Model
+	SpreadsheetID string `json:"spreadsheetId"`
+	Name          string `json:"name"`
+	// Position orders the sheets of a spreadsheet, starting at 0.
+	Position    int `json:"position"`
+	RowCount    int `json:"rowCount"`
+	ColumnCount int `json:"columnCount"`
+	// ConflictPolicy decides whether cell updates against an earlier version of a cell are rejected.
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy" gorm:"default:REJECT_ON_CONFLICT"`
+	// OutOfBoundsCells lists the cells removed by shrinking the sheet, it is only set on the result of an update.
+	OutOfBoundsCells []Cell `json:"outOfBoundsCells,omitempty" gorm:"-"`
+}
+
+func (s Sheet) id() string {
+	return strconv.FormatUint(uint64(s.ID), 10)
+}
+
+func ValidateSheetRowAndColumnIndexes(sheet Sheet, rowIndex int, columnIndex int) error {
+	if rowIndex < 0 || columnIndex < 0 {
+		return fmt.Errorf("row index %d and column index %d must not be negative", rowIndex, columnIndex)
+	}
+	if rowIndex >= sheet.RowCount {
+		return fmt.Errorf("row index %d is greater than row count %d of sheet %s", rowIndex, sheet.RowCount, sheet.Name)
+	}
+	if columnIndex >= sheet.ColumnCount {
+		return fmt.Errorf("column index %d is greater than column count %d of sheet %s", columnIndex, sheet.ColumnCount, sheet.Name)
+	}
+	return nil
+}
+
+// ValidateSheetName checks a sheet name can be used in formulas and is not taken by another sheet of the spreadsheet.
+func ValidateSheetName(name string, sheets []Sheet, self uint) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("sheet name is required")
+	}
+	if len([]rune(name)) > maxSheetNameLength {
+		return fmt.Errorf("sheet name %s is longer than %d characters", name, maxSheetNameLength)
+	}
+	if strings.ContainsAny(name, `[]:*?/\!'"`) {
+		return fmt.Errorf(`sheet name %s cannot contain any of [ ] : * ? / \ ! ' "`, name)
+	}
+	for _, sheet := range sheets {
+		if sheet.ID != self && strings.EqualFold(sheet.Name, name) {
+			return fmt.Errorf("sheet name %s is already used", name)
+		}
+	}
+	return nil
+}
+
+// LoadSheets returns the sheets of a spreadsheet in order.
+func LoadSheets(db *gorm.DB, spreadsheetID string) ([]Sheet, error) {
+	var sheets []Sheet
+	err := db.Where("spreadsheet_id = ?", spreadsheetID).Order("position").Find(&sheets).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheets: %v", err)
+	}
+	return sheets, nil
+}
+
+// sheetByName finds a sheet of the spreadsheet by name, case insensitively like Excel.
+func (s Spreadsheet) sheetByName(name string) (Sheet, bool) {
+	for _, sheet := range s.Sheets {
+		if strings.EqualFold(sheet.Name, name) {
+			return sheet, true
+		}
+	}
+	return Sheet{}, false
+}
+
+func (s Spreadsheet) sheetByID(id string) (Sheet, bool) {
+	for _, sheet := range s.Sheets {
+		if sheet.id() == id {
+			return sheet, true
+		}
+	}
+	return Sheet{}, false
+}
+
+// DefaultSheet returns the sheet a cell is put in when none is given, the first one.
+func DefaultSheet(db *gorm.DB, spreadsheetID string) (Sheet, error) {
+	sheets, err := LoadSheets(db, spreadsheetID)
+	if err != nil {
+		return Sheet{}, err
+	}
+	if len(sheets) == 0 {
+		return Sheet{}, fmt.Errorf("spreadsheet %s has no sheets", spreadsheetID)
+	}
+	return sheets[0], nil
+}
+
+// FindSheet returns the sheet of a spreadsheet with the given ID, or its first sheet when id is empty.
+func FindSheet(db *gorm.DB, spreadsheetID string, id string) (Sheet, error) {
+	if id == "" {
+		return DefaultSheet(db, spreadsheetID)
+	}
+	var sheet Sheet
+	err := db.Where("id = ? AND spreadsheet_id = ?", id, spreadsheetID).First(&sheet).Error
+	if err != nil {
+		return Sheet{}, fmt.Errorf("error getting sheet: %v", err)
+	}
+	return sheet, nil
+}
+
+// splitSheetReference splits a reference such as Sheet2!A1 or 'Q1 Budget'!B2:B10 into the sheet name and
+// the reference within that sheet, the name is empty for references to the formula's own sheet.
+func splitSheetReference(reference string) (string, string) {
+	i := strings.LastIndex(reference, "!")
+	if i < 0 {
+		return "", reference
+	}
+	name := reference[:i]
+	if len(name) >= 2 && strings.HasPrefix(name, "'") && strings.HasSuffix(name, "'") {
+		name = strings.ReplaceAll(name[1:len(name)-1], "''", "'")
+	}
+	return name, reference[i+1:]
+}
+
+var plainSheetNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// quoteSheetName returns a sheet name the way it is written in a formula, quoted unless it is a plain word.
+func quoteSheetName(name string) string {
+	if plainSheetNamePattern.MatchString(name) {
+		return name
+	}
+	return "'" + strings.ReplaceAll(name, "'", "''") + "'"
+}
+
+// referencesSheet reports whether the formula of c refers to any cell of the named sheet.
+func referencesSheet(c *Cell, name string) bool {
+	if len(c.RawValue) == 0 || c.RawValue[0] != '=' {
+		return false
+	}
+	formula, err := c.parseFormula()
+	if err != nil {
+		return false
+	}
+	for _, reference := range formulaReferences(formula) {
+		if sheetName, _ := splitSheetReference(reference); strings.EqualFold(sheetName, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// renameSheetReferences rewrites the references of a formula from one sheet name to another.
+func renameSheetReferences(rawValue string, from string, to string) string {
+	if !strings.HasPrefix(rawValue, "=") {
+		return rawValue
+	}
+	return rewriteFormulaReferences(rawValue, func(reference string) string {
+		name, ref := splitSheetReference(reference)
+		if name == "" || !strings.EqualFold(name, from) {
+			return reference
+		}
+		return quoteSheetName(to) + "!" + ref
+	})
+}
+
+// moveSheet gives sheet the position it asks for among sheets, clamped to the number of sheets, and renumbers the
+// others so positions stay consecutive. sheets must not contain sheet itself. Every sheet whose position changed
+// is returned.
+func moveSheet(sheet *Sheet, sheets []Sheet) []Sheet {
+	sort.SliceStable(sheets, func(i, j int) bool { return sheets[i].Position < sheets[j].Position })
+	if sheet.Position < 0 {
+		sheet.Position = 0
+	}
+	if sheet.Position > len(sheets) {
+		sheet.Position = len(sheets)
+	}
+	var moved []Sheet
+	position := 0
+	for _, other := range sheets {
+		if position == sheet.Position {
+			position++
+		}
+		if other.Position != position {
+			other.Position = position
+			moved = append(moved, other)
+		}
+		position++
+	}
+	return moved
+}
+
+// CreateSheet adds a sheet to its spreadsheet at the requested position, moving the sheets after it along. It is
+// saved as a new version along with the formulas that already used the name of the sheet, and were #REF! so far.
+func CreateSheet(context *common.CustomContext, sheet *Sheet) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		sheets, err := LoadSheets(tx, sheet.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		err = ValidateSheetName(sheet.Name, sheets, 0)
+		if err != nil {
+			return err
+		}
+		err = validateSheetSize(*sheet)
+		if err != nil {
+			return err
+		}
+		version, err = NextVersion(tx, context, sheet.SpreadsheetID, fmt.Sprintf("Created sheet %s", sheet.Name), true)
+		if err != nil {
+			return err
+		}
+		for _, moved := range moveSheet(sheet, sheets) {
+			err = tx.Model(&moved).Update("position", moved.Position).Error
+			if err != nil {
+				return fmt.Errorf("error moving sheet: %v", err)
+			}
+		}
+		err = tx.Create(sheet).Error
+		if err != nil {
+			return fmt.Errorf("error creating sheet: %v", err)
+		}
+
+		spreadsheet, cells, err := loadCalculation(tx, sheet.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		_, _, err = saveRecalculation(tx, cells, sheet.referringCells(cells, spreadsheet.NamedRanges), spreadsheet, version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: sheet.SpreadsheetID, Version: version})
+	return nil
+}
+
+// Update renames, moves or resizes a sheet. Formulas that refer to the sheet by its old name are rewritten
+// to the new one, and cells left outside of a shrunk sheet are removed. Both are saved as a new version along
+// with every cell recalculated because of it, and the previous size is kept for reverting the resize. The non-empty cells that were removed are listed in
+// OutOfBoundsCells, with dryRun they are listed without saving anything.
+func (s *Sheet) Update(context *common.CustomContext, input UpdateSheet, dryRun bool) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		sheets, err := LoadSheets(tx, s.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		var others []Sheet
+		for _, sheet := range sheets {
+			if sheet.ID != s.ID {
+				others = append(others, sheet)
+			}
+		}
+
+		previousName, previousRowCount, previousColumnCount := s.Name, s.RowCount, s.ColumnCount
+		if input.Name != nil {
+			err = ValidateSheetName(*input.Name, sheets, s.ID)
+			if err != nil {
+				return err
+			}
+			s.Name = *input.Name
+		}
+		if input.RowCount != nil {
+			s.RowCount = *input.RowCount
+		}
+		if input.ColumnCount != nil {
+			s.ColumnCount = *input.ColumnCount
+		}
+		if input.ConflictPolicy != nil {
+			s.ConflictPolicy = *input.ConflictPolicy
+		}
+		err = validateSheetSize(*s)
+		if err != nil {
+			return err
+		}
+		shrunk := s.RowCount < previousRowCount || s.ColumnCount < previousColumnCount
+		if dryRun {
+			if !shrunk {
+				return nil
+			}
+			cells, err := latestCells(tx, s.SpreadsheetID)
+			if err != nil {
+				return fmt.Errorf("error getting cells: %v", err)
+			}
+			s.OutOfBoundsCells = s.outOfBoundsCells(cells)
+			return nil
+		}
+
+		// renaming changes what formulas refer to the sheet by, resizing is recorded so that a revert can undo it
+		version, err = NextVersion(tx, context, s.SpreadsheetID, s.updateSummary(previousName, input), s.Name != previousName)
+		if err != nil {
+			return err
+		}
+		if s.RowCount != previousRowCount || s.ColumnCount != previousColumnCount {
+			err = tx.Create(&SheetResize{SpreadsheetID: s.SpreadsheetID, Version: version, SheetID: s.ID, RowCount: previousRowCount, ColumnCount: previousColumnCount}).Error
+			if err != nil {
+				return fmt.Errorf("error saving sheet size: %v", err)
+			}
+		}
+		if input.Position != nil {
+			s.Position = *input.Position
+			for _, moved := range moveSheet(s, others) {
+				err = tx.Model(&moved).Update("position", moved.Position).Error
+				if err != nil {
+					return fmt.Errorf("error moving sheet: %v", err)
+				}
+			}
+		}
+		err = tx.Save(s).Error
+		if err != nil {
+			return fmt.Errorf("error updating sheet: %v", err)
+		}
+
+		renamed := s.Name != previousName
+		if !renamed && !shrunk {
+			return nil
+		}
+		if renamed {
+			err = renameNamedRangeSheets(tx, s.SpreadsheetID, previousName, s.Name)
+			if err != nil {
+				return err
+			}
+		}
+		spreadsheet, cells, err := loadCalculation(tx, s.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		var changed []cellKey
+		if renamed {
+			for i := range cells {
+				rewritten := renameSheetReferences(cells[i].RawValue, previousName, s.Name)
+				// formulas that already used the new name, and were #REF! so far, resolve now
+				if rewritten != cells[i].RawValue || referencesSheet(&cells[i], s.Name) {
+					cells[i].RawValue = rewritten
+					changed = append(changed, keyOf(&cells[i]))
+				}
+			}
+		}
+		if shrunk {
+			// removed cells are saved empty so the previous version can still be reverted to
+			s.OutOfBoundsCells = s.outOfBoundsCells(cells)
+			for _, cell := range s.OutOfBoundsCells {
+				for i := range cells {
+					if keyOf(&cells[i]) == keyOf(&cell) {
+						cells[i].RawValue = ""
+						changed = append(changed, keyOf(&cells[i]))
+					}
+				}
+			}
+		}
+		_, _, err = saveRecalculation(tx, cells, changed, spreadsheet, version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !dryRun {
+		context.Events.Publish(common.Event{SpreadsheetID: s.SpreadsheetID, Version: version})
+	}
+	return nil
+}
+
+// updateSummary describes an update of the sheet, previously named previousName.
+func (s *Sheet) updateSummary(previousName string, input UpdateSheet) string {
+	var changes []string
+	if s.Name != previousName {
+		changes = append(changes, fmt.Sprintf("renamed sheet %s to %s", previousName, s.Name))
+	}
+	if input.RowCount != nil || input.ColumnCount != nil {
+		changes = append(changes, fmt.Sprintf("resized sheet %s to %d rows and %d columns", s.Name, s.RowCount, s.ColumnCount))
+	}
+	if input.Position != nil {
+		changes = append(changes, fmt.Sprintf("moved sheet %s to position %d", s.Name, *input.Position))
+	}
+	if input.ConflictPolicy != nil {
+		changes = append(changes, fmt.Sprintf("set the conflict policy of sheet %s to %s", s.Name, *input.ConflictPolicy))
+	}
+	if len(changes) == 0 {
+		return fmt.Sprintf("Updated sheet %s", s.Name)
+	}
+	summary := strings.Join(changes, ", ")
+	return strings.ToUpper(summary[:1]) + summary[1:]
+}
+
+// outOfBoundsCells returns the non-empty cells of the sheet outside of its rows and columns.
+func (s *Sheet) outOfBoundsCells(cells []Cell) []Cell {
+	var outside []Cell
+	for _, cell := range cells {
+		if cell.SheetID == s.id() && cell.RawValue != "" && (cell.RowIndex >= s.RowCount || cell.ColumnIndex >= s.ColumnCount) {
+			outside = append(outside, cell)
+		}
+	}
+	return outside
+}
+
+func validateSheetSize(sheet Sheet) error {
+	if sheet.RowCount < 1 {
+		return fmt.Errorf("row count %d must be at least 1", sheet.RowCount)
+	}
+	if sheet.ColumnCount < 1 {
+		return fmt.Errorf("column count %d must be at least 1", sheet.ColumnCount)
+	}
+	return nil
+}
+
+// Delete deletes a sheet and its cells. The other sheets move up, and formulas that referred to the
+// sheet, directly or through a name, are recalculated to #REF!. The last sheet of a spreadsheet cannot be deleted.
+func (s *Sheet) Delete(context *common.CustomContext) error {
+	var version uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		sheets, err := LoadSheets(tx, s.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		if len(sheets) <= 1 {
+			return fmt.Errorf("cannot delete the only sheet of a spreadsheet")
+		}
+		version, err = NextVersion(tx, context, s.SpreadsheetID, fmt.Sprintf("Deleted sheet %s", s.Name), true)
+		if err != nil {
+			return err
+		}
+		err = deleteCells(tx, version, "sheet_id = ?", s.ID)
+		if err != nil {
+			return err
+		}
+		err = tx.Delete(s).Error
+		if err != nil {
+			return fmt.Errorf("error deleting sheet: %v", err)
+		}
+		var others []Sheet
+		for _, sheet := range sheets {
+			if sheet.ID != s.ID {
+				others = append(others, sheet)
+			}
+		}
+		// placing a sheet after all others renumbers them without a gap
+		for _, moved := range moveSheet(&Sheet{Position: len(others)}, others) {
+			err = tx.Model(&moved).Update("position", moved.Position).Error
+			if err != nil {
+				return fmt.Errorf("error moving sheet: %v", err)
+			}
+		}
+
+		spreadsheet, cells, err := loadCalculation(tx, s.SpreadsheetID)
+		if err != nil {
+			return err
+		}
+		_, _, err = saveRecalculation(tx, cells, s.referringCells(cells, spreadsheet.NamedRanges), spreadsheet, version)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: s.SpreadsheetID, Version: version})
+	return nil
+}
+
+// referringCells returns the cells whose formulas refer to the sheet, by its name or through a name defined for
+// some of its cells.
+func (s *Sheet) referringCells(cells []Cell, namedRanges []NamedRange) []cellKey {
+	var names []string
+	for _, namedRange := range namedRanges {
+		if sheetName, _ := splitSheetReference(namedRange.Reference); strings.EqualFold(sheetName, s.Name) {
+			names = append(names, namedRange.Name)
+		}
+	}
+	var referring []cellKey
+	for i := range cells {
+		if referencesSheet(&cells[i], s.Name) || usesName(&cells[i], names) {
+			referring = append(referring, keyOf(&cells[i]))
+		}
+	}
+	return referring
+}
@@ -0,0 +1,173 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func testSheets() []Sheet {
+	return []Sheet{
+		{Model: gorm.Model{ID: 1}, Name: "Sheet1", Position: 0, RowCount: 10, ColumnCount: 5},
+		{Model: gorm.Model{ID: 2}, Name: "Sheet2", Position: 1, RowCount: 10, ColumnCount: 5},
+		{Model: gorm.Model{ID: 3}, Name: "Q1 Budget", Position: 2, RowCount: 4, ColumnCount: 2},
+	}
+}
+
+func TestCrossSheetReferences(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 0, RowIndex: 0, RawValue: "1", ComputedValue: "1", ComputedType: ValueTypeNumber},
+		{SheetID: "2", ColumnIndex: 0, RowIndex: 0, RawValue: "20", ComputedValue: "20", ComputedType: ValueTypeNumber},
+		{SheetID: "3", ColumnIndex: 1, RowIndex: 1, RawValue: "300", ComputedValue: "300", ComputedType: ValueTypeNumber},
+		{SheetID: "3", ColumnIndex: 1, RowIndex: 2, RawValue: "400", ComputedValue: "400", ComputedType: ValueTypeNumber},
+	}
+
+	tests := []struct {
+		rawValue string
+		expected string
+	}{
+		{"=A1", "1"},
+		{"=Sheet2!A1", "20"},
+		{"=sheet2!A1+A1", "21"},
+		{"=SUM('Q1 Budget'!B2:B10)", "700"},
+		{"=SUM('Q1 Budget'!B:B)", "700"},
+		{"=Sheet3!A1", "#REF!"},
+	}
+	for _, test := range tests {
+		t.Run(test.rawValue, func(t *testing.T) {
+			c := Cell{SheetID: "1", ColumnIndex: 4, RowIndex: 4, RawValue: test.rawValue}
+			assert.Equal(t, test.expected, c.computeValue(cells, spreadsheet).String())
+		})
+	}
+}
+
+func TestCrossSheetRecalculation(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 0, RawValue: "=Sheet2!A1*2"},
+		{SheetID: "2", ColumnIndex: 1, RowIndex: 0, RawValue: "=SUM('Q1 Budget'!A:A)"},
+		{SheetID: "2", ColumnIndex: 0, RowIndex: 0, RawValue: "=B1"},
+		{SheetID: "3", ColumnIndex: 0, RowIndex: 0, RawValue: "5"},
+	}
+
+	recomputed, _ := recalculate(cells, []cellKey{keyOf(&cells[3])}, spreadsheet)
+
+	assert.Equal(t, 4, len(recomputed))
+	assert.Equal(t, "5", cells[1].ComputedValue)
+	assert.Equal(t, "5", cells[2].ComputedValue)
+	assert.Equal(t, "10", cells[0].ComputedValue)
+}
+
+func TestSplitSheetReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		name      string
+		ref       string
+	}{
+		{"A1", "", "A1"},
+		{"Sheet2!A1", "Sheet2", "A1"},
+		{"Q1 Budget!B2:B10", "Q1 Budget", "B2:B10"},
+		{"'Q1 Budget'!B2:B10", "Q1 Budget", "B2:B10"},
+		{"'Bob''s'!$A$1", "Bob's", "$A$1"},
+	}
+	for _, test := range tests {
+		name, ref := splitSheetReference(test.reference)
+		assert.Equal(t, test.name, name, test.reference)
+		assert.Equal(t, test.ref, ref, test.reference)
+	}
+}
+
+func TestRenameSheetReferences(t *testing.T) {
+	assert.Equal(t, "='Q2 Budget'!A1+A1", renameSheetReferences("=Sheet2!A1+A1", "Sheet2", "Q2 Budget"))
+	assert.Equal(t, "=SUM(Totals!B2:B10)", renameSheetReferences("=SUM('Q1 Budget'!B2:B10)", "q1 budget", "Totals"))
+	assert.Equal(t, `=Sheet1!A1&"Sheet2!A1"`, renameSheetReferences(`=Sheet1!A1&"Sheet2!A1"`, "Sheet2", "Totals"))
+	assert.Equal(t, "Sheet2!A1", renameSheetReferences("Sheet2!A1", "Sheet2", "Totals"))
+}
+
+func TestShiftFormulaKeepsSheet(t *testing.T) {
+	assert.Equal(t, "='Q1 Budget'!B3+Sheet2!$A$1", shiftFormula("='Q1 Budget'!B2+Sheet2!$A$1", 1, 0))
+}
+
+func TestValidateSheetName(t *testing.T) {
+	sheets := testSheets()
+	assert.NoError(t, ValidateSheetName("Totals", sheets, 0))
+	assert.NoError(t, ValidateSheetName("sheet2", sheets, 2))
+	assert.Error(t, ValidateSheetName("sheet2", sheets, 0))
+	assert.Error(t, ValidateSheetName(" ", sheets, 0))
+	assert.Error(t, ValidateSheetName("A:B", sheets, 0))
+	assert.Error(t, ValidateSheetName("Bob's", sheets, 0))
+	assert.Error(t, ValidateSheetName("a sheet name that is longer than excel allows", sheets, 0))
+}
+
+func TestMoveSheet(t *testing.T) {
+	t.Run("moves the sheets after the position along", func(t *testing.T) {
+		sheet := Sheet{Position: 1}
+		moved := moveSheet(&sheet, testSheets())
+		assert.Equal(t, 1, sheet.Position)
+		assert.Equal(t, 2, len(moved))
+		assert.Equal(t, "Sheet2", moved[0].Name)
+		assert.Equal(t, 2, moved[0].Position)
+		assert.Equal(t, "Q1 Budget", moved[1].Name)
+		assert.Equal(t, 3, moved[1].Position)
+	})
+
+	t.Run("clamps the position to after the last sheet", func(t *testing.T) {
+		sheet := Sheet{Position: 10}
+		assert.Empty(t, moveSheet(&sheet, testSheets()))
+		assert.Equal(t, 3, sheet.Position)
+	})
+
+	t.Run("closes the gap a deleted sheet leaves", func(t *testing.T) {
+		others := []Sheet{testSheets()[0], testSheets()[2]}
+		moved := moveSheet(&Sheet{Position: len(others)}, others)
+		assert.Equal(t, 1, len(moved))
+		assert.Equal(t, "Q1 Budget", moved[0].Name)
+		assert.Equal(t, 1, moved[0].Position)
+	})
+}
+
+func TestOutOfBoundsCells(t *testing.T) {
+	sheet := testSheets()[2]
+	cells := []Cell{
+		{SheetID: "3", ColumnIndex: 1, RowIndex: 3, RawValue: "1"},
+		{SheetID: "3", ColumnIndex: 2, RowIndex: 0, RawValue: "2"},
+		{SheetID: "3", ColumnIndex: 0, RowIndex: 4, RawValue: "=A1"},
+		{SheetID: "3", ColumnIndex: 0, RowIndex: 5, RawValue: ""},
+		{SheetID: "1", ColumnIndex: 9, RowIndex: 9, RawValue: "3"},
+	}
+	outside := sheet.outOfBoundsCells(cells)
+	assert.Equal(t, 2, len(outside))
+	assert.Equal(t, "2", outside[0].RawValue)
+	assert.Equal(t, "=A1", outside[1].RawValue)
+}
+
+func TestReferringCells(t *testing.T) {
+	sheets := testSheets()
+	namedRanges := []NamedRange{
+		{Name: "TaxRate", Reference: "'Q1 Budget'!B2"},
+		{Name: "Revenue", Reference: "Sheet1!A1:A3"},
+	}
+	cells := []Cell{
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 0, RawValue: "=taxrate*2", ComputedValue: "600", ComputedType: ValueTypeNumber},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "=SUM('Q1 Budget'!B:B)", ComputedValue: "300", ComputedType: ValueTypeNumber},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 2, RawValue: "=SUM(Revenue)", ComputedValue: "0", ComputedType: ValueTypeNumber},
+		{SheetID: "1", ColumnIndex: 1, RowIndex: 3, RawValue: "5", ComputedValue: "5", ComputedType: ValueTypeNumber},
+	}
+
+	referring := sheets[2].referringCells(cells, namedRanges)
+	assert.Equal(t, []cellKey{keyOf(&cells[0]), keyOf(&cells[1])}, referring)
+
+	// once the sheet is gone both are #REF! instead of keeping their values
+	spreadsheet := Spreadsheet{Sheets: sheets[:2], NamedRanges: namedRanges}
+	recalculate(cells, referring, spreadsheet)
+	assert.Equal(t, "#REF!", cells[0].ComputedValue)
+	assert.Equal(t, "#REF!", cells[1].ComputedValue)
+	assert.Equal(t, "0", cells[2].ComputedValue)
+}
+
+func TestValidateSheetSize(t *testing.T) {
+	assert.NoError(t, validateSheetSize(Sheet{RowCount: 1, ColumnCount: 1}))
+	assert.Error(t, validateSheetSize(Sheet{RowCount: 0, ColumnCount: 1}))
+	assert.Error(t, validateSheetSize(Sheet{RowCount: 1, ColumnCount: -1}))
+}
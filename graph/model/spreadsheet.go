@@ -5,11 +5,27 @@ import (
 	"gorm.io/gorm"
 )
 
+// Defaults used for iterative calculation when a spreadsheet does not set its own.
+const (
+	DefaultMaxIterations        = 100
+	DefaultConvergenceThreshold = 0.001
+)
+
 type Spreadsheet struct {
 	gorm.Model
 	Name        string `json:"name"`
 	RowCount    int    `json:"rowCount"`
 	ColumnCount int    `json:"columnCount"`
+	// IterativeCalculation computes circular references by iteration instead of evaluating them to #CYCLE!.
+	IterativeCalculation bool    `json:"iterativeCalculation"`
+	MaxIterations        int     `json:"maxIterations"`
+	ConvergenceThreshold float64 `json:"convergenceThreshold"`
+	// Sheets and NamedRanges are only loaded to recalculate cells, references to other sheets and names used
+	// in formulas are resolved through them.
+	Sheets      []Sheet      `json:"-" gorm:"-"`
+	NamedRanges []NamedRange `json:"-" gorm:"-"`
+	// OutOfBoundsCells lists the cells removed by shrinking the first sheet, it is only set on the result of an update.
+	OutOfBoundsCells []Cell `json:"outOfBoundsCells,omitempty" gorm:"-"`
 }
 
 func ValidateRowAndColumnIndexes(spreadsheet Spreadsheet, rowIndex int, columnIndex int) error {
@@ -22,3 +38,13 @@ func ValidateRowAndColumnIndexes(spreadsheet Spreadsheet, rowIndex int, columnIn
 	}
 	return nil
 }
+
+func ValidateCalculationSettings(spreadsheet Spreadsheet) error {
+	if spreadsheet.MaxIterations < 1 {
+		return fmt.Errorf("max iterations %d must be at least 1", spreadsheet.MaxIterations)
+	}
+	if spreadsheet.ConvergenceThreshold < 0 {
+		return fmt.Errorf("convergence threshold %v must not be negative", spreadsheet.ConvergenceThreshold)
+	}
+	return nil
+}
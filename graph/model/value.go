@@ -0,0 +1,520 @@
+package model
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValueType is the kind of value a formula evaluates to.
+type ValueType string
+
+const (
+	ValueTypeEmpty   ValueType = "EMPTY"
+	ValueTypeNumber  ValueType = "NUMBER"
+	ValueTypeString  ValueType = "STRING"
+	ValueTypeBoolean ValueType = "BOOLEAN"
+	// ValueTypeDate holds an Excel compatible serial number, days since 1899-12-30.
+	ValueTypeDate  ValueType = "DATE"
+	ValueTypeError ValueType = "ERROR"
+	// ValueTypeArray is only produced by range references while a formula is evaluated, it is never stored on a cell.
+	ValueTypeArray ValueType = "ARRAY"
+)
+
+func (e ValueType) IsValid() bool {
+	switch e {
+	case ValueTypeEmpty, ValueTypeNumber, ValueTypeString, ValueTypeBoolean, ValueTypeDate, ValueTypeError:
+		return true
+	}
+	return false
+}
+
+func (e ValueType) String() string {
+	return string(e)
+}
+
+func (e *ValueType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ValueType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ValueType", str)
+	}
+	return nil
+}
+
+func (e ValueType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// dateLayout and dateTimeLayout are how dates, with or without a time of day, are written to and read from a cell's ComputedValue.
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02T15:04:05"
+)
+
+// dateInputLayouts are the ISO and common locale formats a typed in date is recognized in. Dates with slashes
+// are read month first like in the US, unless that fails because the first number is above 12.
+var dateInputLayouts = []string{
+	dateLayout,
+	dateTimeLayout,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.RFC3339,
+	"2006/1/2",
+	"1/2/2006",
+	"1/2/2006 15:04",
+	"1/2/2006 3:04 PM",
+	"2/1/2006",
+	"2/1/2006 15:04",
+	"2.1.2006",
+	"2.1.2006 15:04",
+	"2-Jan-2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+}
+
+// serialEpoch is day zero of Excel's serial date system, chosen so that 1900-03-01 is serial 61 like in Excel.
+var serialEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// secondsPerDay is the length of a day in a serial number, which ignores leap seconds like Excel does.
+const secondsPerDay = 24 * 60 * 60
+
+// dateSerial and timeFromSerial work in whole seconds rather than a time.Duration, which cannot hold the span
+// between serialEpoch and dates after 2192.
+func dateSerial(t time.Time) float64 {
+	seconds := t.Unix() - serialEpoch.Unix()
+	return float64(seconds)/secondsPerDay + float64(t.Nanosecond())/(secondsPerDay*1e9)
+}
+
+func timeFromSerial(serial float64) time.Time {
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * secondsPerDay)
+	return serialEpoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+}
+
+// parseDate reads a date or date and time in one of the dateInputLayouts. Times with a zone are converted to UTC.
+func parseDate(raw string) (time.Time, bool) {
+	raw = strings.TrimSpace(raw)
+	for _, layout := range dateInputLayouts {
+		if date, err := time.Parse(layout, raw); err == nil {
+			return date.UTC(), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Value is the result of evaluating a formula or a part of one.
+type Value struct {
+	Type    ValueType
+	Number  float64
+	Text    string
+	Boolean bool
+	// Error is only set when Type is ValueTypeError.
+	Error *FormulaError
+	// Array holds the rows of an array, only set when Type is ValueTypeArray and Range is nil.
+	Array [][]Value
+	// Range holds the written cells of a range read from a sheet, it is set in place of Array.
+	Range *rangeValues
+}
+
+// rangeValues are the cells of a range read from a sheet, positions that were never written are empty. Only the
+// written cells are kept so that a whole column such as A:A costs as much as the cells in it, functions that
+// work on the shape of a range expand it with Value.rows.
+type rangeValues struct {
+	rowCount    int
+	columnCount int
+	// cells are the written cells ordered row by row
+	cells []rangeCell
+	// byPosition finds the written cells by row and column, built on first use
+	byPosition map[[2]int]Value
+}
+
+// rangeCell is a written cell of a range, row and column are relative to the start of the range.
+type rangeCell struct {
+	row    int
+	column int
+	value  Value
+}
+
+func (r *rangeValues) at(row int, column int) Value {
+	if r.byPosition == nil {
+		r.byPosition = make(map[[2]int]Value, len(r.cells))
+		for _, cell := range r.cells {
+			r.byPosition[[2]int{cell.row, cell.column}] = cell.value
+		}
+	}
+	if value, ok := r.byPosition[[2]int{row, column}]; ok {
+		return value
+	}
+	return EmptyValue()
+}
+
+func EmptyValue() Value {
+	return Value{Type: ValueTypeEmpty}
+}
+
+func NumberValue(number float64) Value {
+	return Value{Type: ValueTypeNumber, Number: number}
+}
+
+func StringValue(text string) Value {
+	return Value{Type: ValueTypeString, Text: text}
+}
+
+func BooleanValue(boolean bool) Value {
+	return Value{Type: ValueTypeBoolean, Boolean: boolean}
+}
+
+func DateValue(t time.Time) Value {
+	return Value{Type: ValueTypeDate, Number: dateSerial(t)}
+}
+
+func ErrorValue(code ErrorCode, message string) Value {
+	return Value{Type: ValueTypeError, Error: &FormulaError{Code: code, Message: message}}
+}
+
+func ArrayValue(rows [][]Value) Value {
+	return Value{Type: ValueTypeArray, Array: rows}
+}
+
+func rangeValue(values *rangeValues) Value {
+	return Value{Type: ValueTypeArray, Range: values}
+}
+
+// decimalPattern is a plain decimal number, optionally signed and with an exponent. strconv.ParseFloat alone
+// would also take NaN, Inf, hexadecimal and underscore separated numbers that a spreadsheet reads as text.
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// parseNumber reads a finite decimal number, ignoring surrounding whitespace.
+func parseNumber(raw string) (float64, bool) {
+	raw = strings.TrimSpace(raw)
+	if !decimalPattern.MatchString(raw) {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(raw, 64)
+	if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+		return 0, false
+	}
+	return number, true
+}
+
+// parseValue infers the type of a plain (non formula) string such as a literal a user typed into a cell.
+func parseValue(raw string) Value {
+	if raw == "" {
+		return EmptyValue()
+	}
+	if number, ok := parseNumber(raw); ok {
+		return NumberValue(number)
+	}
+	if raw == "TRUE" || raw == "FALSE" {
+		return BooleanValue(raw == "TRUE")
+	}
+	if date, ok := parseDate(raw); ok {
+		return DateValue(date)
+	}
+	return StringValue(raw)
+}
+
+// parseTypedValue reads back a value that was stored as a string along with its type.
+func parseTypedValue(valueType ValueType, raw string) Value {
+	switch valueType {
+	case "":
+		// stored before cells had a computed type
+		return parseValue(raw)
+	case ValueTypeEmpty:
+		return EmptyValue()
+	case ValueTypeNumber:
+		if number, ok := parseNumber(raw); ok {
+			return NumberValue(number)
+		}
+	case ValueTypeBoolean:
+		return BooleanValue(raw == "TRUE")
+	case ValueTypeDate:
+		if date, ok := parseDate(raw); ok {
+			return DateValue(date)
+		}
+	case ValueTypeError:
+		return ErrorValue(ErrorCode(raw), defaultErrorMessages[ErrorCode(raw)])
+	}
+	return StringValue(raw)
+}
+
+// String renders the value the way it is stored in a cell's ComputedValue.
+func (v Value) String() string {
+	switch v.Type {
+	case ValueTypeNumber:
+		return strconv.FormatFloat(v.Number, 'f', -1, 64)
+	case ValueTypeString:
+		return v.Text
+	case ValueTypeBoolean:
+		if v.Boolean {
+			return "TRUE"
+		}
+		return "FALSE"
+	case ValueTypeDate:
+		date := timeFromSerial(v.Number)
+		if date.Hour() == 0 && date.Minute() == 0 && date.Second() == 0 {
+			return date.Format(dateLayout)
+		}
+		return date.Format(dateTimeLayout)
+	case ValueTypeError:
+		return string(v.Error.Code)
+	case ValueTypeArray:
+		if rowCount, columnCount := v.size(); rowCount > 0 && columnCount > 0 {
+			return v.at(0, 0).String()
+		}
+	}
+	return ""
+}
+
+// size returns the number of rows and columns of an array, a single value is one by one.
+func (v Value) size() (int, int) {
+	switch {
+	case v.Range != nil:
+		return v.Range.rowCount, v.Range.columnCount
+	case v.Type != ValueTypeArray:
+		return 1, 1
+	case len(v.Array) == 0:
+		return 0, 0
+	}
+	return len(v.Array), len(v.Array[0])
+}
+
+// at returns the value at a zero based row and column of an array, a single value is at 0, 0.
+func (v Value) at(row int, column int) Value {
+	switch {
+	case v.Range != nil:
+		return v.Range.at(row, column)
+	case v.Type == ValueTypeArray:
+		return v.Array[row][column]
+	}
+	return v
+}
+
+// rows returns the rows of an array with a value for every position, a single value is a one cell array.
+func (v Value) rows() [][]Value {
+	switch {
+	case v.Range == nil && v.Type == ValueTypeArray:
+		return v.Array
+	case v.Range == nil:
+		return [][]Value{{v}}
+	}
+	rows := make([][]Value, v.Range.rowCount)
+	for i := range rows {
+		rows[i] = make([]Value, v.Range.columnCount)
+		for j := range rows[i] {
+			rows[i][j] = EmptyValue()
+		}
+	}
+	for _, cell := range v.Range.cells {
+		rows[cell.row][cell.column] = cell.value
+	}
+	return rows
+}
+
+// Values returns every value in an array row by row, or the value itself for scalars.
+func (v Value) Values() []Value {
+	if v.Type != ValueTypeArray {
+		return []Value{v}
+	}
+	var values []Value
+	for _, row := range v.rows() {
+		values = append(values, row...)
+	}
+	return values
+}
+
+// writtenValues is Values without the positions of a range that were never written, for functions that skip
+// blanks anyway.
+func (v Value) writtenValues() []Value {
+	if v.Range == nil {
+		return v.Values()
+	}
+	values := make([]Value, len(v.Range.cells))
+	for i, cell := range v.Range.cells {
+		values[i] = cell.value
+	}
+	return values
+}
+
+// writtenCells returns the values of an array with their zero based row and column, row by row and without the
+// positions of a range that were never written. A single value is at 0, 0.
+func (v Value) writtenCells() []rangeCell {
+	if v.Range != nil {
+		return v.Range.cells
+	}
+	var cells []rangeCell
+	for i, row := range v.rows() {
+		for j, value := range row {
+			cells = append(cells, rangeCell{row: i, column: j, value: value})
+		}
+	}
+	return cells
+}
+
+// slice returns the rowCount by columnCount part of an array from a zero based row and column, the part of a
+// range holds only its written cells as well.
+func (v Value) slice(row int, column int, rowCount int, columnCount int) Value {
+	if v.Range == nil {
+		rows := v.rows()[row : row+rowCount]
+		sliced := make([][]Value, rowCount)
+		for i := range sliced {
+			sliced[i] = rows[i][column : column+columnCount]
+		}
+		return ArrayValue(sliced)
+	}
+	values := &rangeValues{rowCount: rowCount, columnCount: columnCount}
+	for _, cell := range v.Range.cells {
+		if cell.row >= row && cell.row < row+rowCount && cell.column >= column && cell.column < column+columnCount {
+			values.cells = append(values.cells, rangeCell{row: cell.row - row, column: cell.column - column, value: cell.value})
+		}
+	}
+	return rangeValue(values)
+}
+
+// isNumeric reports whether the value is counted by aggregates like SUM when it comes from a range.
+func (v Value) isNumeric() bool {
+	return v.Type == ValueTypeNumber || v.Type == ValueTypeDate
+}
+
+// scalar collapses a single cell range to its value, larger ranges cannot be used where one value is expected.
+// Error values are returned as their *FormulaError so they propagate through the surrounding expression.
+func (v Value) scalar() (Value, error) {
+	if v.Type == ValueTypeArray {
+		if rowCount, columnCount := v.size(); rowCount != 1 || columnCount != 1 {
+			return Value{}, newFormulaError(ErrorCodeValue, "a range cannot be used as a single value")
+		}
+		v = v.at(0, 0)
+	}
+	if v.Type == ValueTypeError {
+		return Value{}, v.Error
+	}
+	return v, nil
+}
+
+// toNumber coerces the value to a number using spreadsheet semantics, empty is 0 and booleans are 1 or 0.
+func (v Value) toNumber() (float64, error) {
+	v, err := v.scalar()
+	if err != nil {
+		return 0, err
+	}
+	switch v.Type {
+	case ValueTypeNumber, ValueTypeDate:
+		return v.Number, nil
+	case ValueTypeBoolean:
+		if v.Boolean {
+			return 1, nil
+		}
+		return 0, nil
+	case ValueTypeString:
+		if number, ok := parseNumber(v.Text); ok {
+			return number, nil
+		}
+		// like Excel, text that reads as a date converts to its serial number
+		if date, ok := parseDate(v.Text); ok {
+			return dateSerial(date), nil
+		}
+		return 0, newFormulaError(ErrorCodeValue, "cannot convert %q to a number", v.Text)
+	}
+	return 0, nil
+}
+
+// toBoolean coerces the value to a boolean, numbers are TRUE unless they are 0 and empty is FALSE.
+func (v Value) toBoolean() (bool, error) {
+	v, err := v.scalar()
+	if err != nil {
+		return false, err
+	}
+	switch v.Type {
+	case ValueTypeBoolean:
+		return v.Boolean, nil
+	case ValueTypeNumber, ValueTypeDate:
+		return v.Number != 0, nil
+	case ValueTypeString:
+		switch strings.ToUpper(strings.TrimSpace(v.Text)) {
+		case "TRUE":
+			return true, nil
+		case "FALSE":
+			return false, nil
+		}
+		return false, newFormulaError(ErrorCodeValue, "cannot convert %q to a boolean", v.Text)
+	}
+	return false, nil
+}
+
+func (v Value) toText() (string, error) {
+	v, err := v.scalar()
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+// comparisonRank orders the value types the way Excel sorts them, numbers before text before booleans.
+func comparisonRank(v Value) int {
+	switch v.Type {
+	case ValueTypeString:
+		return 1
+	case ValueTypeBoolean:
+		return 2
+	}
+	return 0
+}
+
+// compareValues orders two scalar values like the comparison operators do and returns -1, 0 or 1.
+// Text is compared case insensitively and empty equals 0, "" or FALSE depending on what it is compared with.
+func compareValues(left Value, right Value) int {
+	if left.Type == ValueTypeEmpty {
+		left = emptyLike(right)
+	}
+	if right.Type == ValueTypeEmpty {
+		right = emptyLike(left)
+	}
+	if leftRank, rightRank := comparisonRank(left), comparisonRank(right); leftRank != rightRank {
+		if leftRank < rightRank {
+			return -1
+		}
+		return 1
+	}
+	switch left.Type {
+	case ValueTypeString:
+		return strings.Compare(strings.ToLower(left.Text), strings.ToLower(right.Text))
+	case ValueTypeBoolean:
+		if left.Boolean == right.Boolean {
+			return 0
+		}
+		if right.Boolean {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case left.Number < right.Number:
+		return -1
+	case left.Number > right.Number:
+		return 1
+	}
+	return 0
+}
+
+// emptyLike returns the blank value of the same kind as v.
+func emptyLike(v Value) Value {
+	switch v.Type {
+	case ValueTypeString:
+		return StringValue("")
+	case ValueTypeBoolean:
+		return BooleanValue(false)
+	}
+	return NumberValue(0)
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParseValue(t *testing.T) {
+	assert.Equal(t, EmptyValue(), parseValue(""))
+	assert.Equal(t, NumberValue(1.5), parseValue("1.50"))
+	assert.Equal(t, BooleanValue(true), parseValue("TRUE"))
+	assert.Equal(t, StringValue("true story"), parseValue("true story"))
+	assert.Equal(t, DateValue(time.Date(2023, 7, 5, 0, 0, 0, 0, time.UTC)), parseValue("2023-07-05"))
+	assert.Equal(t, NumberValue(-1200), parseValue(" -1.2e3 "))
+	assert.Equal(t, NumberValue(0.5), parseValue(".5"))
+	for _, raw := range []string{"nan", "NaN", "Inf", "-infinity", "1_000", "0x10", "1e400"} {
+		assert.Equal(t, StringValue(raw), parseValue(raw), raw)
+	}
+}
+
+func TestParseDateInputs(t *testing.T) {
+	july5 := DateValue(time.Date(2023, 7, 5, 0, 0, 0, 0, time.UTC))
+	for _, raw := range []string{"2023/7/5", "7/5/2023", "07/05/2023", "5.7.2023", "5-Jul-2023", "5 July 2023", "Jul 5, 2023", "July 5, 2023"} {
+		assert.Equal(t, july5, parseValue(raw), raw)
+	}
+	// slashes are read day first when the month would be out of range
+	assert.Equal(t, DateValue(time.Date(2023, 7, 25, 0, 0, 0, 0, time.UTC)), parseValue("25/7/2023"))
+
+	afternoon := DateValue(time.Date(2023, 7, 5, 13, 30, 0, 0, time.UTC))
+	for _, raw := range []string{"2023-07-05T13:30:00", "2023-07-05 13:30", "2023-07-05T15:30:00+02:00", "7/5/2023 1:30 PM"} {
+		assert.Equal(t, afternoon, parseValue(raw), raw)
+	}
+	assert.Equal(t, "2023-07-05T13:30:00", afternoon.String())
+	assert.Equal(t, afternoon, parseTypedValue(ValueTypeDate, "2023-07-05T13:30:00"))
+
+	assert.Equal(t, StringValue("7/5"), parseValue("7/5"))
+	assert.Equal(t, StringValue("13/13/2023"), parseValue("13/13/2023"))
+}
+
+func TestParseTypedValue(t *testing.T) {
+	t.Run("keeps the stored type", func(t *testing.T) {
+		assert.Equal(t, StringValue("42"), parseTypedValue(ValueTypeString, "42"))
+		assert.Equal(t, NumberValue(42), parseTypedValue(ValueTypeNumber, "42"))
+		assert.Equal(t, BooleanValue(false), parseTypedValue(ValueTypeBoolean, "FALSE"))
+		assert.Equal(t, EmptyValue(), parseTypedValue(ValueTypeEmpty, ""))
+	})
+
+	t.Run("infers the type of values stored without one", func(t *testing.T) {
+		assert.Equal(t, NumberValue(42), parseTypedValue("", "42"))
+		assert.Equal(t, StringValue("ERROR"), parseTypedValue("", "ERROR"))
+	})
+}
+
+func TestDateSerial(t *testing.T) {
+	// serial numbers match Excel's 1900 date system after 1900-02-28
+	assert.Equal(t, float64(61), dateSerial(time.Date(1900, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, float64(45112), dateSerial(time.Date(2023, 7, 5, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2023-07-05", DateValue(time.Date(2023, 7, 5, 0, 0, 0, 0, time.UTC)).String())
+	assert.Equal(t, time.Date(2023, 7, 5, 12, 0, 0, 0, time.UTC), timeFromSerial(45112.5))
+
+	// past the roughly 292 years a time.Duration can hold
+	assert.Equal(t, float64(109574), dateSerial(time.Date(2199, 12, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(9999, 12, 31, 18, 0, 0, 0, time.UTC), timeFromSerial(dateSerial(time.Date(9999, 12, 31, 18, 0, 0, 0, time.UTC))))
+	assert.Equal(t, "2300-01-01", parseValue("2300-01-01").String())
+	assert.Equal(t, "1899-12-29T18:00:00", DateValue(timeFromSerial(-0.25)).String())
+}
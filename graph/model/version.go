@@ -0,0 +1,238 @@
+package model
+
+import (
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+// maxSummaryValueLength is how much of a raw value a version summary quotes.
+const maxSummaryValueLength = 40
+
+// Version is a change saved to a spreadsheet. Versions count up from 1 in each spreadsheet and are handed out
+// inside the transaction that saves the change, so they order the changes as they were committed.
+type Version struct {
+	SpreadsheetID string `json:"spreadsheetId" gorm:"primaryKey"`
+	Version       uint64 `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	// Author is who made the change as given by the client, nil when it did not say.
+	Author *string `json:"author"`
+	// Summary describes the change, such as Set Sheet1!B2 to 42.
+	Summary string `json:"summary"`
+	// Structural is set for changes to the sheets or names of a spreadsheet, such as renaming a sheet. Only the
+	// cells and sheet sizes of a spreadsheet are versioned, so it cannot be reverted to a version before a
+	// structural change.
+	Structural bool      `json:"-"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (Version) TableName() string {
+	return "spreadsheet_versions"
+}
+
+// SheetResize is the size a sheet had before a version resized it, reverting to an earlier version restores it.
+type SheetResize struct {
+	SpreadsheetID string `gorm:"primaryKey"`
+	Version       uint64 `gorm:"primaryKey;autoIncrement:false"`
+	SheetID       uint   `gorm:"primaryKey;autoIncrement:false"`
+	RowCount      int
+	ColumnCount   int
+}
+
+// lockSpreadsheet holds off other changes to the spreadsheet until tx ends.
+func lockSpreadsheet(tx *gorm.DB, spreadsheetID string) error {
+	var spreadsheet Spreadsheet
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", spreadsheetID).First(&spreadsheet).Error
+	if err != nil {
+		return fmt.Errorf("error locking spreadsheet: %v", err)
+	}
+	return nil
+}
+
+// NextVersion saves the next version of a spreadsheet within tx and returns it. The spreadsheet stays locked
+// until tx ends, so concurrent changes get increasing versions in the order they commit. structural marks a
+// change to the sheets or names of the spreadsheet, see Version.Structural.
+func NextVersion(tx *gorm.DB, context *common.CustomContext, spreadsheetID string, summary string, structural bool) (uint64, error) {
+	err := lockSpreadsheet(tx, spreadsheetID)
+	if err != nil {
+		return 0, err
+	}
+	var latest uint64
+	err = tx.Model(&Version{}).Select("coalesce(max(version), 0)").Where("spreadsheet_id = ?", spreadsheetID).Scan(&latest).Error
+	if err != nil {
+		return 0, fmt.Errorf("error getting version: %v", err)
+	}
+	version := Version{SpreadsheetID: spreadsheetID, Version: latest + 1, Summary: summary, Structural: structural, CreatedAt: time.Now()}
+	if context.Author != "" {
+		version.Author = &context.Author
+	}
+	err = tx.Create(&version).Error
+	if err != nil {
+		return 0, fmt.Errorf("error saving version: %v", err)
+	}
+	return version.Version, nil
+}
+
+// LoadVersions returns the versions of a spreadsheet, oldest first.
+func LoadVersions(db *gorm.DB, spreadsheetID string) ([]*Version, error) {
+	var versions []*Version
+	err := db.Where("spreadsheet_id = ?", spreadsheetID).Order("version").Find(&versions).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting versions: %v", err)
+	}
+	return versions, nil
+}
+
+// deleteCells soft deletes the cells matching query under version, which lets clients following the changes
+// of the spreadsheet find out about them.
+func deleteCells(tx *gorm.DB, version uint64, query interface{}, args ...interface{}) error {
+	err := tx.Model(&Cell{}).Where(query, args...).Updates(map[string]interface{}{"deleted_at": time.Now(), "deleted_version": version}).Error
+	if err != nil {
+		return fmt.Errorf("error deleting cells: %v", err)
+	}
+	return nil
+}
+
+// RevertSpreadsheet takes the cells of a spreadsheet back to how they were at version by deleting the cells
+// saved after it and saving again the cells of version that an earlier revert deleted, and gives the sheets
+// resized since back their size. The revert is a version of its own, the
+// versions it undid stay in the history. It returns the version of the revert. Reverting past a structural
+// version is refused, as the sheets and names it changed are not versioned and the cells from before it would
+// not fit them.
+func RevertSpreadsheet(context *common.CustomContext, spreadsheetID string, version uint64) (uint64, error) {
+	var revert uint64
+	err := context.Database.Transaction(func(tx *gorm.DB) error {
+		var err error
+		revert, err = NextVersion(tx, context, spreadsheetID, fmt.Sprintf("Reverted to version %d", version), false)
+		if err != nil {
+			return err
+		}
+		if version >= revert {
+			return fmt.Errorf("version %d not found in spreadsheet %s", version, spreadsheetID)
+		}
+		var structural Version
+		err = tx.Where("spreadsheet_id = ? AND version > ? AND structural", spreadsheetID, version).Order("version").Limit(1).Find(&structural).Error
+		if err != nil {
+			return fmt.Errorf("error getting versions: %v", err)
+		}
+		if structural.Version != 0 {
+			return fmt.Errorf("cannot revert to version %d, version %d changed the structure of the spreadsheet: %s", version, structural.Version, structural.Summary)
+		}
+		err = restoreSheetSizes(tx, spreadsheetID, version, revert)
+		if err != nil {
+			return err
+		}
+		err = deleteCells(tx, revert, "spreadsheet_id = ? AND version > ?", spreadsheetID, version)
+		if err != nil {
+			return err
+		}
+		return restoreRevertedCells(tx, spreadsheetID, version, revert)
+	})
+	if err != nil {
+		return 0, err
+	}
+	context.Events.Publish(common.Event{SpreadsheetID: spreadsheetID, Version: revert})
+	return revert, nil
+}
+
+// restoreRevertedCells saves under revert the cells of a spreadsheet at version that were deleted after it. Only
+// a revert to an earlier version deletes cells without a structural change, which is why reverting to a version
+// that such a revert undid would otherwise leave the cells as they were before it.
+func restoreRevertedCells(tx *gorm.DB, spreadsheetID string, version uint64, revert uint64) error {
+	var cells []Cell
+	err := tx.Unscoped().Select("DISTINCT ON (sheet_id, row_index, column_index) *").
+		Where("spreadsheet_id = ? AND version <= ? AND (deleted_version IS NULL OR deleted_version > ?)", spreadsheetID, version, version).
+		Order("sheet_id, row_index, column_index, version desc").Find(&cells).Error
+	if err != nil {
+		return fmt.Errorf("error getting cells: %v", err)
+	}
+	var restored []Cell
+	for _, cell := range cells {
+		if cell.DeletedVersion == nil {
+			continue
+		}
+		restored = append(restored, Cell{
+			SpreadsheetID: cell.SpreadsheetID,
+			SheetID:       cell.SheetID,
+			RawValue:      cell.RawValue,
+			ComputedValue: cell.ComputedValue,
+			ComputedType:  cell.ComputedType,
+			ErrorMessage:  cell.ErrorMessage,
+			RowIndex:      cell.RowIndex,
+			ColumnIndex:   cell.ColumnIndex,
+			Version:       revert,
+		})
+	}
+	if len(restored) == 0 {
+		return nil
+	}
+	err = tx.Create(&restored).Error
+	if err != nil {
+		return fmt.Errorf("error restoring cells: %v", err)
+	}
+	return nil
+}
+
+// restoreSheetSizes gives the sheets of a spreadsheet resized after version the size they had before, recording
+// the sizes they had until then under revert. The first sheet sets the size of the spreadsheet, so it is
+// restored as well.
+func restoreSheetSizes(tx *gorm.DB, spreadsheetID string, version uint64, revert uint64) error {
+	var resizes []SheetResize
+	err := tx.Where("spreadsheet_id = ? AND version > ?", spreadsheetID, version).Order("version").Find(&resizes).Error
+	if err != nil {
+		return fmt.Errorf("error getting sheet sizes: %v", err)
+	}
+	if len(resizes) == 0 {
+		return nil
+	}
+	sheets, err := LoadSheets(tx, spreadsheetID)
+	if err != nil {
+		return err
+	}
+	restored := make(map[uint]bool)
+	for _, resize := range resizes {
+		// the earliest resize after version holds the size the sheet had at version
+		if restored[resize.SheetID] {
+			continue
+		}
+		restored[resize.SheetID] = true
+		for _, sheet := range sheets {
+			if sheet.ID != resize.SheetID || (sheet.RowCount == resize.RowCount && sheet.ColumnCount == resize.ColumnCount) {
+				continue
+			}
+			err = tx.Create(&SheetResize{SpreadsheetID: spreadsheetID, Version: revert, SheetID: sheet.ID, RowCount: sheet.RowCount, ColumnCount: sheet.ColumnCount}).Error
+			if err != nil {
+				return fmt.Errorf("error saving sheet size: %v", err)
+			}
+			size := map[string]interface{}{"row_count": resize.RowCount, "column_count": resize.ColumnCount}
+			err = tx.Model(&Sheet{}).Where("id = ?", sheet.ID).Updates(size).Error
+			if err != nil {
+				return fmt.Errorf("error resizing sheet: %v", err)
+			}
+			if sheet.Position == 0 {
+				err = tx.Model(&Spreadsheet{}).Where("id = ?", spreadsheetID).Updates(size).Error
+				if err != nil {
+					return fmt.Errorf("error resizing spreadsheet: %v", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// cellSummary describes setting a cell, quoting the start of long values.
+func cellSummary(spreadsheet Spreadsheet, c Cell) string {
+	code := keyOf(&c).code()
+	if sheet, ok := spreadsheet.sheetByID(c.SheetID); ok {
+		code = quoteSheetName(sheet.Name) + "!" + code
+	}
+	value := []rune(c.RawValue)
+	if len(value) == 0 {
+		return fmt.Sprintf("Cleared %s", code)
+	}
+	if len(value) > maxSummaryValueLength {
+		value = append(value[:maxSummaryValueLength], '…')
+	}
+	return fmt.Sprintf("Set %s to %s", code, string(value))
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestVersionSummaries(t *testing.T) {
+	spreadsheet := Spreadsheet{Sheets: testSheets()}
+
+	t.Run("cells are named with their sheet", func(t *testing.T) {
+		assert.Equal(t, "Set Sheet1!B2 to =A1*2", cellSummary(spreadsheet, Cell{SheetID: "1", ColumnIndex: 1, RowIndex: 1, RawValue: "=A1*2"}))
+		assert.Equal(t, "Cleared 'Q1 Budget'!A1", cellSummary(spreadsheet, Cell{SheetID: "3"}))
+		long := cellSummary(spreadsheet, Cell{SheetID: "1", RawValue: strings.Repeat("é", 50)})
+		assert.Equal(t, "Set Sheet1!A1 to "+strings.Repeat("é", maxSummaryValueLength)+"…", long)
+	})
+
+	t.Run("sheet updates list each change", func(t *testing.T) {
+		rows, position := 20, 1
+		policy := ConflictPolicyLastWriterWins
+		sheet := Sheet{Name: "Budget", RowCount: 20, ColumnCount: 5}
+		assert.Equal(t, "Renamed sheet Sheet1 to Budget, resized sheet Budget to 20 rows and 5 columns",
+			sheet.updateSummary("Sheet1", UpdateSheet{RowCount: &rows}))
+		assert.Equal(t, "Moved sheet Budget to position 1, set the conflict policy of sheet Budget to LAST_WRITER_WINS",
+			sheet.updateSummary("Budget", UpdateSheet{Position: &position, ConflictPolicy: &policy}))
+		assert.Equal(t, "Updated sheet Budget", sheet.updateSummary("Budget", UpdateSheet{}))
+	})
+
+	t.Run("inserted and deleted rows and columns", func(t *testing.T) {
+		assert.Equal(t, "3", gridShift{}.code(2))
+		assert.Equal(t, "C", gridShift{columns: true}.code(2))
+	})
+}
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"time"
 
 	"github.com/WinterYukky/gorm-extra-clause-plugin/exclause"
 	"github.com/vijaykramesh/gql-sheets/graph/common"
@@ -32,11 +31,61 @@ func (r *cellResolver) Spreadsheet(ctx context.Context, obj *model.Cell) (*model
 	return &spreadsheet, nil
 }
 
+// ComputedType is the resolver for the computedType field.
+func (r *cellResolver) ComputedType(ctx context.Context, obj *model.Cell) (model.ValueType, error) {
+	return obj.TypedValue().Type, nil
+}
+
+// NumberValue is the resolver for the numberValue field.
+func (r *cellResolver) NumberValue(ctx context.Context, obj *model.Cell) (*float64, error) {
+	value := obj.TypedValue()
+	if value.Type != model.ValueTypeNumber && value.Type != model.ValueTypeDate {
+		return nil, nil
+	}
+	return &value.Number, nil
+}
+
+// BooleanValue is the resolver for the booleanValue field.
+func (r *cellResolver) BooleanValue(ctx context.Context, obj *model.Cell) (*bool, error) {
+	value := obj.TypedValue()
+	if value.Type != model.ValueTypeBoolean {
+		return nil, nil
+	}
+	return &value.Boolean, nil
+}
+
+// DateValue is the resolver for the dateValue field.
+func (r *cellResolver) DateValue(ctx context.Context, obj *model.Cell) (*string, error) {
+	value := obj.TypedValue()
+	if value.Type != model.ValueTypeDate {
+		return nil, nil
+	}
+	date := value.String()
+	return &date, nil
+}
+
+// ComputedError is the resolver for the computedError field.
+func (r *cellResolver) ComputedError(ctx context.Context, obj *model.Cell) (*model.CellError, error) {
+	value := obj.TypedValue()
+	if value.Type != model.ValueTypeError {
+		return nil, nil
+	}
+	return &model.CellError{
+		Code:    string(value.Error.Code),
+		Message: value.Error.Message,
+	}, nil
+}
+
 // Version is the resolver for the version field.
 func (r *cellResolver) Version(ctx context.Context, obj *model.Cell) (string, error) {
 	return strconv.FormatUint(obj.Version, 10), nil
 }
 
+// Version is the resolver for the version field.
+func (r *cellChangesResolver) Version(ctx context.Context, obj *model.CellChanges) (string, error) {
+	return strconv.FormatUint(obj.Version, 10), nil
+}
+
 // CreateCell is the resolver for the createCell field.
 func (r *mutationResolver) CreateCell(ctx context.Context, input model.NewCell) (*model.Cell, error) {
 	context := common.GetContext(ctx)
@@ -45,7 +94,15 @@ func (r *mutationResolver) CreateCell(ctx context.Context, input model.NewCell)
 	if err != nil {
 		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
 	}
-	err = model.ValidateRowAndColumnIndexes(spreadsheet, input.RowIndex, input.ColumnIndex)
+	sheetID := ""
+	if input.SheetID != nil {
+		sheetID = *input.SheetID
+	}
+	sheet, err := model.FindSheet(context.Database, input.SpreadsheetID, sheetID)
+	if err != nil {
+		return nil, err
+	}
+	err = model.ValidateSheetRowAndColumnIndexes(sheet, input.RowIndex, input.ColumnIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -54,12 +111,10 @@ func (r *mutationResolver) CreateCell(ctx context.Context, input model.NewCell)
 		RowIndex:      input.RowIndex,
 		ColumnIndex:   input.ColumnIndex,
 		SpreadsheetID: input.SpreadsheetID,
+		SheetID:       strconv.FormatUint(uint64(sheet.ID), 10),
 	}
-	err = context.Database.Create(&cell).Error
-	if err != nil {
-		return nil, fmt.Errorf("error creating cell: %v", err)
-	}
-	return cell, nil
+	// created like any other change, so formulas already referring to the position are recalculated
+	return cell.UpdateCellAndDependentCells(context, model.UpdateCell{RawValue: input.RawValue})
 }
 
 // UpdateCell is the resolver for the updateCell field.
@@ -70,34 +125,87 @@ func (r *mutationResolver) UpdateCell(ctx context.Context, id string, input mode
 	if err != nil {
 		return nil, fmt.Errorf("error getting cell: %v", err)
 	}
-	var spreadsheet model.Spreadsheet
-	err = context.Database.Where("id = ?", cell.SpreadsheetID).First(&spreadsheet).Error
+	sheet, err := model.FindSheet(context.Database, cell.SpreadsheetID, cell.SheetID)
 	if err != nil {
-		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
+		return nil, err
 	}
-	err = model.ValidateRowAndColumnIndexes(spreadsheet, cell.RowIndex, cell.ColumnIndex)
+	err = model.ValidateSheetRowAndColumnIndexes(sheet, cell.RowIndex, cell.ColumnIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	return cell.UpdateCellAndDependentCells(context, input)
+	updated, err := cell.UpdateCellAndDependentCells(context, input)
+	return updated, conflictError(err)
 }
 
 // UpdateCellBySpreadsheetIDColumnAndRow is the resolver for the updateCellBySpreadsheetIdColumnAndRow field.
-func (r *mutationResolver) UpdateCellBySpreadsheetIDColumnAndRow(ctx context.Context, spreadsheetID string, columnIndex int, rowIndex int, input model.UpdateCell) (*model.Cell, error) {
+func (r *mutationResolver) UpdateCellBySpreadsheetIDColumnAndRow(ctx context.Context, spreadsheetID string, columnIndex int, rowIndex int, input model.UpdateCell, sheetID *string) (*model.Cell, error) {
 	context := common.GetContext(ctx)
+	id := ""
+	if sheetID != nil {
+		id = *sheetID
+	}
+	sheet, err := model.FindSheet(context.Database, spreadsheetID, id)
+	if err != nil {
+		return nil, err
+	}
+	err = model.ValidateSheetRowAndColumnIndexes(sheet, rowIndex, columnIndex)
+	if err != nil {
+		return nil, err
+	}
 	var cell model.Cell
-	err := context.Database.Where("spreadsheet_id = ? AND column_index = ? AND row_index = ?", spreadsheetID, columnIndex, rowIndex).First(&cell).Error
+	err = context.Database.Where("sheet_id = ? AND column_index = ? AND row_index = ?", sheet.ID, columnIndex, rowIndex).First(&cell).Error
 	if err != nil {
 		// create a cell if it doesn't exist
 		cell = model.Cell{
 			SpreadsheetID: spreadsheetID,
+			SheetID:       strconv.FormatUint(uint64(sheet.ID), 10),
+			ColumnIndex:   columnIndex,
+			RowIndex:      rowIndex,
+		}
+	}
+
+	updated, err := cell.UpdateCellAndDependentCells(context, input)
+	return updated, conflictError(err)
+}
+
+// UpdateCells is the resolver for the updateCells field.
+func (r *mutationResolver) UpdateCells(ctx context.Context, spreadsheetID string, updates []*model.CellUpdate) ([]*model.Cell, error) {
+	context := common.GetContext(ctx)
+	cells, err := model.UpdateCells(context, spreadsheetID, updates)
+	return cells, conflictError(err)
+}
+
+// CopyCell is the resolver for the copyCell field.
+func (r *mutationResolver) CopyCell(ctx context.Context, id string, columnIndex int, rowIndex int) (*model.Cell, error) {
+	context := common.GetContext(ctx)
+	var source model.Cell
+	err := context.Database.Where("id = ?", id).First(&source).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting cell: %v", err)
+	}
+	// the copy stays in the sheet of the source cell
+	sheet, err := model.FindSheet(context.Database, source.SpreadsheetID, source.SheetID)
+	if err != nil {
+		return nil, err
+	}
+	err = model.ValidateSheetRowAndColumnIndexes(sheet, rowIndex, columnIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var cell model.Cell
+	err = context.Database.Where("sheet_id = ? AND column_index = ? AND row_index = ?", sheet.ID, columnIndex, rowIndex).First(&cell).Error
+	if err != nil {
+		cell = model.Cell{
+			SpreadsheetID: source.SpreadsheetID,
+			SheetID:       strconv.FormatUint(uint64(sheet.ID), 10),
 			ColumnIndex:   columnIndex,
 			RowIndex:      rowIndex,
 		}
 	}
 
-	return cell.UpdateCellAndDependentCells(context, input)
+	return cell.UpdateCellAndDependentCells(context, model.UpdateCell{RawValue: source.CopiedRawValue(columnIndex, rowIndex)})
 }
 
 // Cells is the resolver for the cells field.
@@ -128,26 +236,113 @@ func (r *queryResolver) GetCell(ctx context.Context, id string) (*model.Cell, er
 func (r *queryResolver) GetCellsBySpreadsheetID(ctx context.Context, spreadsheetID string) ([]*model.Cell, error) {
 	context := common.GetContext(ctx)
 	var cells []*model.Cell
-	err := context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("column_index,row_index,max(version) as version").Where("deleted_at IS NULL").Group("column_index,row_index"))).Where("spreadsheet_id = ? AND version = (SELECT version FROM cte WHERE column_index = cells.column_index AND row_index = cells.row_index)", spreadsheetID).Find(&cells).Error
+	err := context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("sheet_id,column_index,row_index,max(version) as version").Where("deleted_at IS NULL").Group("sheet_id,column_index,row_index"))).Where("spreadsheet_id = ? AND version = (SELECT version FROM cte WHERE sheet_id = cells.sheet_id AND column_index = cells.column_index AND row_index = cells.row_index)", spreadsheetID).Find(&cells).Error
 	if err != nil {
 		return nil, fmt.Errorf("error getting cells: %v", err)
 	}
 	return cells, nil
 }
 
+// GetCellsInRange is the resolver for the getCellsInRange field.
+func (r *queryResolver) GetCellsInRange(ctx context.Context, spreadsheetID string, rangeArg string, sheetID *string) ([]*model.Cell, error) {
+	context := common.GetContext(ctx)
+	id := ""
+	if sheetID != nil {
+		id = *sheetID
+	}
+	return model.CellsInRange(context.Database, spreadsheetID, id, rangeArg)
+}
+
+// GetCellsInBounds is the resolver for the getCellsInBounds field.
+func (r *queryResolver) GetCellsInBounds(ctx context.Context, spreadsheetID string, bounds model.CellBounds, sheetID *string) ([]*model.Cell, error) {
+	context := common.GetContext(ctx)
+	id := ""
+	if sheetID != nil {
+		id = *sheetID
+	}
+	return model.CellsInBounds(context.Database, spreadsheetID, id, bounds)
+}
+
 // GetCellsBySpreadsheetID is the resolver for the getCellsBySpreadsheetId field.
 func (r *subscriptionResolver) GetCellsBySpreadsheetID(ctx context.Context, spreadsheetID string) (<-chan []*model.Cell, error) {
+	context := common.GetContext(ctx)
+	events := context.Events.Subscribe(ctx, spreadsheetID)
+	load := func() ([]*model.Cell, error) {
+		var cells []*model.Cell
+		err := context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("sheet_id,column_index,row_index,max(version) as version").Where("deleted_at IS NULL").Group("sheet_id,column_index,row_index"))).Where("spreadsheet_id = ? AND version = (SELECT version FROM cte WHERE sheet_id = cells.sheet_id AND column_index = cells.column_index AND row_index = cells.row_index)", spreadsheetID).Find(&cells).Error
+		if err != nil {
+			return nil, fmt.Errorf("error getting cells: %v", err)
+		}
+		return cells, nil
+	}
+	cells, err := load()
+	if err != nil {
+		return nil, err
+	}
+
 	ch := make(chan []*model.Cell)
 	go func() {
+		defer close(ch)
 		for {
-			context := common.GetContext(ctx)
-			time.Sleep(1 * time.Second)
-			var cells []*model.Cell
-			err := context.Database.Clauses(exclause.NewWith("cte", context.Database.Table("cells").Select("column_index,row_index,max(version) as version").Where("deleted_at IS NULL").Group("column_index,row_index"))).Where("spreadsheet_id = ? AND version = (SELECT version FROM cte WHERE column_index = cells.column_index AND row_index = cells.row_index)", spreadsheetID).Find(&cells).Error
+			select {
+			case ch <- cells:
+			case <-ctx.Done():
+				return
+			}
+			// the events channel is closed once the client disconnects
+			if _, ok := <-events; !ok {
+				return
+			}
+			cells, err = load()
 			if err != nil {
-				panic(fmt.Errorf("error getting cells: %v", err))
+				addSubscriptionError(ctx, err)
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// CellChanges is the resolver for the cellChanges field.
+func (r *subscriptionResolver) CellChanges(ctx context.Context, spreadsheetID string, sinceVersion *string) (<-chan *model.CellChanges, error) {
+	context := common.GetContext(ctx)
+	var since uint64
+	if sinceVersion != nil {
+		var err error
+		since, err = strconv.ParseUint(*sinceVersion, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %s", *sinceVersion)
+		}
+	}
+	events := context.Events.Subscribe(ctx, spreadsheetID)
+	changes, err := model.CellChangesSince(context.Database, spreadsheetID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.CellChanges)
+	go func() {
+		defer close(ch)
+		for {
+			select {
+			case ch <- changes:
+			case <-ctx.Done():
+				return
+			}
+			// only events that changed something since the last version sent are passed on
+			for {
+				if _, ok := <-events; !ok {
+					return
+				}
+				changes, err = model.CellChangesSince(context.Database, spreadsheetID, changes.Version)
+				if err != nil {
+					addSubscriptionError(ctx, err)
+					return
+				}
+				if len(changes.Cells) > 0 || len(changes.DeletedCells) > 0 {
+					break
+				}
 			}
-			ch <- cells
 		}
 	}()
 	return ch, nil
@@ -156,6 +351,9 @@ func (r *subscriptionResolver) GetCellsBySpreadsheetID(ctx context.Context, spre
 // Cell returns generated.CellResolver implementation.
 func (r *Resolver) Cell() generated.CellResolver { return &cellResolver{r} }
 
+// CellChanges returns generated.CellChangesResolver implementation.
+func (r *Resolver) CellChanges() generated.CellChangesResolver { return &cellChangesResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
@@ -166,6 +364,7 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
 type cellResolver struct{ *Resolver }
+type cellChangesResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
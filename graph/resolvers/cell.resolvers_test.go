@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	"encoding/json"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/stretchr/testify/assert"
@@ -12,6 +13,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
 func TestCellResolver_ID(t *testing.T) {
@@ -187,6 +189,8 @@ func TestMutationResolver_CreateCell(t *testing.T) {
 		})
 		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 1, 1))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 1, 1))
 
 		// Create a test context with the mocked database
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -215,8 +219,17 @@ func TestMutationResolver_CreateCell(t *testing.T) {
 		}`
 
 		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 1, 1))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 1, 1))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}))
+		expectNextVersion(mock, "1", 1)
 		mock.ExpectQuery(`INSERT INTO "cells"`).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "Test Cell", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "Test Cell", "Test Cell", "STRING", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), 1).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		mock.ExpectCommit()
 		// expect panic here
@@ -225,6 +238,68 @@ func TestMutationResolver_CreateCell(t *testing.T) {
 
 		assert.NotNil(t, resp.CreateCell)
 		assert.Equal(t, "1", resp.CreateCell.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should recalculate the formulas referring to the new cell", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 2, 2))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 2, 2))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 2, 2))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 2, 2))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "computed_value", "computed_type", "row_index", "column_index", "version"}).AddRow(1, "1", "1", "=A1*2", "0", "NUMBER", 0, 1, 1))
+		expectNextVersion(mock, "1", 2)
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "1", "3", "3", "NUMBER", "", 0, 0, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "1", "=A1*2", "6", "NUMBER", "", 0, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
+		mock.ExpectCommit()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			CreateCell struct {
+				ID            string
+				ComputedValue string
+				Version       string
+			}
+		}{}
+
+		q := `mutation createCell {
+			createCell(input: {rawValue: "3", rowIndex: 0, columnIndex: 0, spreadsheetId: "1"}) {
+				id
+				computedValue
+				version
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, "2", resp.CreateCell.ID)
+		assert.Equal(t, "3", resp.CreateCell.ComputedValue)
+		assert.Equal(t, "2", resp.CreateCell.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("should fail to create a cell outside column and row counts", func(t *testing.T) {
@@ -236,6 +311,8 @@ func TestMutationResolver_CreateCell(t *testing.T) {
 		})
 		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 1, 1))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 1, 1))
 
 		// Create a test context with the mocked database
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -358,3 +435,637 @@ func TestCellResolver_Spreadsheet(t *testing.T) {
 		require.Equal(t, "Test Spreadsheet", resp.GetCell.Spreadsheet.Name)
 	})
 }
+
+func TestCellResolver_TypedValues(t *testing.T) {
+	t.Run("should expose the computed type and typed accessors of a cell", func(t *testing.T) {
+		mockDb, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDb,
+			DriverName: "postgres",
+		})
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "raw_value", "computed_value", "computed_type"}).AddRow(1, "=A1*2", "4.5", "NUMBER"))
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCell struct {
+				ComputedType string
+				NumberValue  *float64
+				BooleanValue *bool
+				DateValue    *string
+			}
+		}{}
+
+		q := `query getCellTypedValue {
+			getCell(id: "1") {
+				computedType
+				numberValue
+				booleanValue
+				dateValue
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		require.Equal(t, "NUMBER", resp.GetCell.ComputedType)
+		require.NotNil(t, resp.GetCell.NumberValue)
+		require.Equal(t, 4.5, *resp.GetCell.NumberValue)
+		require.Nil(t, resp.GetCell.BooleanValue)
+		require.Nil(t, resp.GetCell.DateValue)
+	})
+
+	t.Run("should expose the code and message of an error value", func(t *testing.T) {
+		mockDb, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDb,
+			DriverName: "postgres",
+		})
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "raw_value", "computed_value", "computed_type", "error_message"}).AddRow(1, "=A1/0", "#DIV/0!", "ERROR", "division by zero"))
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCell struct {
+				ComputedValue string
+				ComputedType  string
+				ComputedError *struct {
+					Code    string
+					Message string
+				}
+			}
+		}{}
+
+		q := `query getCellError {
+			getCell(id: "1") {
+				computedValue
+				computedType
+				computedError {
+					code
+					message
+				}
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		require.Equal(t, "#DIV/0!", resp.GetCell.ComputedValue)
+		require.Equal(t, "ERROR", resp.GetCell.ComputedType)
+		require.NotNil(t, resp.GetCell.ComputedError)
+		require.Equal(t, "#DIV/0!", resp.GetCell.ComputedError.Code)
+		require.Equal(t, "division by zero", resp.GetCell.ComputedError.Message)
+	})
+
+	t.Run("should infer the type of cells saved without one", func(t *testing.T) {
+		mockDb, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDb,
+			DriverName: "postgres",
+		})
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "raw_value", "computed_value"}).AddRow(1, "2023-07-05", "2023-07-05"))
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCell struct {
+				ComputedType string
+				NumberValue  *float64
+				DateValue    *string
+			}
+		}{}
+
+		q := `query getCellTypedValue {
+			getCell(id: "1") {
+				computedType
+				numberValue
+				dateValue
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		require.Equal(t, "DATE", resp.GetCell.ComputedType)
+		require.NotNil(t, resp.GetCell.NumberValue)
+		require.Equal(t, float64(45112), *resp.GetCell.NumberValue)
+		require.NotNil(t, resp.GetCell.DateValue)
+		require.Equal(t, "2023-07-05", *resp.GetCell.DateValue)
+	})
+}
+
+func TestMutationResolver_CopyCell(t *testing.T) {
+	t.Run("should fail to copy a cell outside column and row counts", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "raw_value", "row_index", "column_index"}).AddRow(1, "1", "=$A$1*B2", 1, 1))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 2, 2))
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			CopyCell *model.Cell
+		}{}
+
+		q := `mutation copyCell {
+			copyCell(id: "1", columnIndex: 1, rowIndex: 2) {
+				id
+			}
+		}`
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "panic should have occurred")
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}()
+
+		gql.MustPost(q, &resp)
+		assert.Nil(t, resp.CopyCell)
+	})
+}
+
+func TestMutationResolver_UpdateCells(t *testing.T) {
+	t.Run("should save the updated cells and their dependents under one version", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}).AddRow(1, "1", "1", "=B1+1", 0, 2, 1))
+		expectNextVersion(mock, "1", 2)
+		for i := 2; i <= 4; i++ {
+			mock.ExpectQuery(`INSERT INTO "cells"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(i))
+		}
+		mock.ExpectCommit()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateCells []struct {
+				RawValue      string
+				ComputedValue string
+				ColumnIndex   int
+				Version       string
+			}
+		}{}
+
+		q := `mutation updateCells {
+			updateCells(spreadsheetId: "1", updates: [
+				{columnIndex: 0, rowIndex: 0, rawValue: "2"},
+				{columnIndex: 1, rowIndex: 0, rawValue: "=A1*2"}
+			]) {
+				rawValue
+				computedValue
+				columnIndex
+				version
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, 3, len(resp.UpdateCells))
+		assert.Equal(t, "2", resp.UpdateCells[0].ComputedValue)
+		assert.Equal(t, "4", resp.UpdateCells[1].ComputedValue)
+		assert.Equal(t, 2, resp.UpdateCells[2].ColumnIndex)
+		assert.Equal(t, "5", resp.UpdateCells[2].ComputedValue)
+		assert.Equal(t, resp.UpdateCells[0].Version, resp.UpdateCells[2].Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should fail to update a cell outside column and row counts", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}))
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateCells []*model.Cell
+		}{}
+
+		q := `mutation updateCells {
+			updateCells(spreadsheetId: "1", updates: [
+				{columnIndex: 0, rowIndex: 0, rawValue: "1"},
+				{columnIndex: 5, rowIndex: 0, rawValue: "2"}
+			]) {
+				id
+			}
+		}`
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "panic should have occurred")
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}()
+
+		gql.MustPost(q, &resp)
+		assert.Nil(t, resp.UpdateCells)
+	})
+
+	t.Run("should save nothing for an empty batch", func(t *testing.T) {
+		// Mock the database without expectations, any query fails the test
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateCells []*model.Cell
+		}{}
+
+		gql.MustPost(`mutation updateCells {
+			updateCells(spreadsheetId: "1", updates: []) {
+				id
+			}
+		}`, &resp)
+
+		assert.NotNil(t, resp.UpdateCells)
+		assert.Empty(t, resp.UpdateCells)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMutationResolver_UpdateCellsExpectedVersion(t *testing.T) {
+	expectCalculation := func(mock sqlmock.Sqlmock, conflictPolicy string) {
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count", "conflict_policy"}).AddRow(1, "1", "Sheet1", 0, 10, 5, conflictPolicy))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "computed_value", "row_index", "column_index", "version"}).AddRow(3, "1", "1", "2", "2", 0, 0, 7))
+	}
+	q := `mutation updateCells {
+		updateCells(spreadsheetId: "1", updates: [{columnIndex: 0, rowIndex: 0, rawValue: "3", expectedVersion: "5"}]) {
+			rawValue
+		}
+	}`
+
+	t.Run("should reject an update made against an earlier version with the current cell", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		expectCalculation(mock, "REJECT_ON_CONFLICT")
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp, err := gql.RawPost(q)
+		assert.NoError(t, err)
+
+		var errors []struct {
+			Message    string
+			Extensions struct {
+				Code            string
+				ExpectedVersion string
+				CurrentCell     struct {
+					ID       string
+					RawValue string
+					Version  string
+				}
+			}
+		}
+		assert.NoError(t, json.Unmarshal(resp.Errors, &errors))
+		assert.Equal(t, 1, len(errors))
+		assert.Equal(t, "cell A1 was changed at version 7, expected version 5", errors[0].Message)
+		assert.Equal(t, "CONFLICT", errors[0].Extensions.Code)
+		assert.Equal(t, "5", errors[0].Extensions.ExpectedVersion)
+		assert.Equal(t, "3", errors[0].Extensions.CurrentCell.ID)
+		assert.Equal(t, "2", errors[0].Extensions.CurrentCell.RawValue)
+		assert.Equal(t, "7", errors[0].Extensions.CurrentCell.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should save a stale update when the last writer wins", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		expectCalculation(mock, "LAST_WRITER_WINS")
+		expectNextVersion(mock, "1", 8)
+		mock.ExpectQuery(`INSERT INTO "cells"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectCommit()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateCells []struct {
+				RawValue string
+			}
+		}{}
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, "3", resp.UpdateCells[0].RawValue)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestQueryResolver_GetCellsInRange(t *testing.T) {
+	t.Run("should only query the cells within the range", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).
+				AddRow(1, "1", "Sheet1", 0, 100, 26).
+				AddRow(2, "1", "Q1 Budget", 1, 100, 26))
+		mock.ExpectQuery(`SELECT DISTINCT ON \(row_index, column_index\) \* FROM "cells" WHERE .+ ORDER BY row_index, column_index, version desc`).
+			WithArgs(2, 1, 99, 1, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}).
+				AddRow(3, "1", "2", "7", 1, 1, 2).
+				AddRow(4, "1", "2", "8", 2, 1, 1))
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCellsInRange []struct {
+				RawValue string
+				RowIndex int
+			}
+		}{}
+
+		q := `query getCellsInRange {
+			getCellsInRange(spreadsheetId: "1", range: "'Q1 Budget'!B2:F200") {
+				rawValue
+				rowIndex
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, 2, len(resp.GetCellsInRange))
+		assert.Equal(t, "7", resp.GetCellsInRange[0].RawValue)
+		assert.Equal(t, 2, resp.GetCellsInRange[1].RowIndex)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should fail for an invalid range", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCellsInRange []*model.Cell
+		}{}
+
+		q := `query getCellsInRange {
+			getCellsInRange(spreadsheetId: "1", range: "B2:") {
+				id
+			}
+		}`
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "panic should have occurred")
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}()
+
+		gql.MustPost(q, &resp)
+		assert.Nil(t, resp.GetCellsInRange)
+	})
+}
+
+func TestQueryResolver_GetCellsInBounds(t *testing.T) {
+	t.Run("should query the cells within the bounds of the first sheet", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 100, 26))
+		mock.ExpectQuery(`SELECT DISTINCT ON \(row_index, column_index\) \* FROM "cells" WHERE .+ ORDER BY row_index, column_index, version desc`).
+			WithArgs(1, 0, 49, 0, 9).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}).
+				AddRow(1, "1", "1", "1", 0, 0, 1))
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetCellsInBounds []struct {
+				RawValue string
+			}
+		}{}
+
+		q := `query getCellsInBounds {
+			getCellsInBounds(spreadsheetId: "1", bounds: {startRowIndex: 0, startColumnIndex: 0, endRowIndex: 49, endColumnIndex: 9}) {
+				rawValue
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, 1, len(resp.GetCellsInBounds))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSubscriptionResolver_CellChanges(t *testing.T) {
+	t.Run("should replay the changes after a version and then stream new ones", func(t *testing.T) {
+		// Create a test context with a mock database and an event bus
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		events := common.NewEventBus()
+		customCtx := &common.CustomContext{
+			Database: db,
+			Events:   events,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		columns := []string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version", "deleted_at", "deleted_version"}
+		// A1 was changed, the revert took B1 back to an earlier cell and emptied C1
+		mock.ExpectQuery(`SELECT DISTINCT ON \(sheet_id, row_index, column_index\) \* FROM "cells" WHERE \(spreadsheet_id = \$1 AND version > \$2\) AND "cells"."deleted_at" IS NULL`).
+			WithArgs("1", 100).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(5, "1", "1", "7", 0, 0, 150, nil, nil))
+		mock.ExpectQuery(`SELECT DISTINCT ON \(sheet_id, row_index, column_index\) \* FROM "cells" WHERE spreadsheet_id = \$1 AND deleted_version > \$2`).
+			WithArgs("1", 100).
+			WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(3, "1", "1", "2", 0, 1, 120, time.Now(), 300).
+				AddRow(4, "1", "1", "3", 0, 2, 120, time.Now(), 300))
+		mock.ExpectQuery(`SELECT DISTINCT ON \(sheet_id, row_index, column_index\) \* FROM "cells" WHERE \(spreadsheet_id = \$1 AND \(sheet_id, row_index, column_index\) IN \(\(\$2,\$3,\$4\),\(\$5,\$6,\$7\)\)\)`).
+			WithArgs("1", "1", 0, 1, "1", 0, 2).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "1", "1", "1", 0, 1, 90, nil, nil))
+		// the next mutation changes A2
+		mock.ExpectQuery(`SELECT DISTINCT ON .+ version > \$2`).
+			WithArgs("1", 300).
+			WillReturnRows(sqlmock.NewRows(columns).AddRow(6, "1", "1", "8", 1, 0, 400, nil, nil))
+		mock.ExpectQuery(`SELECT DISTINCT ON .+ deleted_version > \$2`).
+			WithArgs("1", 300).
+			WillReturnRows(sqlmock.NewRows(columns))
+
+		gql := client.New(ctx)
+		sub := gql.Websocket(`subscription cellChanges {
+			cellChanges(spreadsheetId: "1", sinceVersion: "100") {
+				version
+				cells {
+					rawValue
+					version
+				}
+				deletedCells {
+					columnIndex
+				}
+			}
+		}`)
+		defer sub.Close()
+
+		var resp struct {
+			CellChanges struct {
+				Version string
+				Cells   []struct {
+					RawValue string
+					Version  string
+				}
+				DeletedCells []struct {
+					ColumnIndex int
+				}
+			}
+		}
+		require.NoError(t, sub.Next(&resp))
+		assert.Equal(t, "300", resp.CellChanges.Version)
+		assert.Equal(t, 2, len(resp.CellChanges.Cells))
+		assert.Equal(t, "7", resp.CellChanges.Cells[0].RawValue)
+		assert.Equal(t, "1", resp.CellChanges.Cells[1].RawValue)
+		assert.Equal(t, "90", resp.CellChanges.Cells[1].Version)
+		assert.Equal(t, 1, len(resp.CellChanges.DeletedCells))
+		assert.Equal(t, 2, resp.CellChanges.DeletedCells[0].ColumnIndex)
+
+		events.Publish(common.Event{SpreadsheetID: "1", Version: 400})
+		require.NoError(t, sub.Next(&resp))
+		assert.Equal(t, "400", resp.CellChanges.Version)
+		assert.Equal(t, 1, len(resp.CellChanges.Cells))
+		assert.Equal(t, "8", resp.CellChanges.Cells[0].RawValue)
+		assert.Empty(t, resp.CellChanges.DeletedCells)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,39 @@
+package resolvers
+
+import (
+	"errors"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"strconv"
+)
+
+// conflictError turns a model.ConflictError into a GraphQL error with the CONFLICT code, carrying the current
+// cell in its extensions so the client can merge or retry without fetching it. Other errors are returned as is.
+func conflictError(err error) error {
+	var conflict *model.ConflictError
+	if !errors.As(err, &conflict) {
+		return err
+	}
+	cell := conflict.Cell
+	var id interface{}
+	if cell.ID != 0 {
+		id = strconv.FormatUint(uint64(cell.ID), 10)
+	}
+	return &gqlerror.Error{
+		Message: err.Error(),
+		Extensions: map[string]interface{}{
+			"code":            "CONFLICT",
+			"expectedVersion": strconv.FormatUint(conflict.ExpectedVersion, 10),
+			"currentCell": map[string]interface{}{
+				"id":            id,
+				"sheetId":       cell.SheetID,
+				"columnIndex":   cell.ColumnIndex,
+				"rowIndex":      cell.RowIndex,
+				"rawValue":      cell.RawValue,
+				"computedValue": cell.ComputedValue,
+				"computedType":  cell.TypedValue().Type,
+				"version":       strconv.FormatUint(cell.Version, 10),
+			},
+		},
+	}
+}
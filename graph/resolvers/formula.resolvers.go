@@ -0,0 +1,32 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+)
+
+// MaxArgs is the resolver for the maxArgs field.
+func (r *formulaFunctionResolver) MaxArgs(ctx context.Context, obj *model.FormulaFunction) (*int, error) {
+	if obj.MaxArgs == model.UnlimitedArgs {
+		return nil, nil
+	}
+	return &obj.MaxArgs, nil
+}
+
+// FormulaFunctions is the resolver for the formulaFunctions field.
+func (r *queryResolver) FormulaFunctions(ctx context.Context) ([]*model.FormulaFunction, error) {
+	return model.FormulaFunctions(), nil
+}
+
+// FormulaFunction returns generated.FormulaFunctionResolver implementation.
+func (r *Resolver) FormulaFunction() generated.FormulaFunctionResolver {
+	return &formulaFunctionResolver{r}
+}
+
+type formulaFunctionResolver struct{ *Resolver }
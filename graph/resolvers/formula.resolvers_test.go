@@ -0,0 +1,63 @@
+package resolvers
+
+import (
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"testing"
+)
+
+func TestQueryResolver_FormulaFunctions(t *testing.T) {
+	t.Run("should list the registered functions with their signatures", func(t *testing.T) {
+		customCtx := &common.CustomContext{}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			FormulaFunctions []struct {
+				Name      string
+				MinArgs   int
+				MaxArgs   *int
+				Signature string
+				Arguments []struct {
+					Name string
+					Type string
+				}
+			}
+		}{}
+
+		q := `query formulaFunctions {
+			formulaFunctions {
+				name
+				minArgs
+				maxArgs
+				signature
+				arguments {
+					name
+					type
+				}
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		functions := make(map[string]int)
+		for i, function := range resp.FormulaFunctions {
+			functions[function.Name] = i
+		}
+		require.Contains(t, functions, "SUM")
+		sum := resp.FormulaFunctions[functions["SUM"]]
+		require.Equal(t, 1, sum.MinArgs)
+		require.Nil(t, sum.MaxArgs)
+		require.Equal(t, "SUM(value, ...)", sum.Signature)
+		require.Equal(t, "NUMBER", sum.Arguments[0].Type)
+
+		require.Contains(t, functions, "LEFT")
+		left := resp.FormulaFunctions[functions["LEFT"]]
+		require.Equal(t, 2, *left.MaxArgs)
+		require.Equal(t, "LEFT(text, [count])", left.Signature)
+	})
+}
@@ -0,0 +1,85 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"strconv"
+)
+
+// CreateNamedRange is the resolver for the createNamedRange field.
+func (r *mutationResolver) CreateNamedRange(ctx context.Context, input model.NewNamedRange) (*model.NamedRange, error) {
+	context := common.GetContext(ctx)
+	var spreadsheet model.Spreadsheet
+	err := context.Database.Where("id = ?", input.SpreadsheetID).First(&spreadsheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
+	}
+	namedRange := &model.NamedRange{
+		SpreadsheetID: input.SpreadsheetID,
+		Name:          input.Name,
+		Reference:     input.Reference,
+	}
+	err = model.CreateNamedRange(context, namedRange)
+	if err != nil {
+		return nil, err
+	}
+	return namedRange, nil
+}
+
+// UpdateNamedRange is the resolver for the updateNamedRange field.
+func (r *mutationResolver) UpdateNamedRange(ctx context.Context, id string, input model.UpdateNamedRange) (*model.NamedRange, error) {
+	context := common.GetContext(ctx)
+	var namedRange model.NamedRange
+	err := context.Database.Where("id = ?", id).First(&namedRange).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting named range: %v", err)
+	}
+	err = namedRange.Update(context, input)
+	if err != nil {
+		return nil, err
+	}
+	return &namedRange, nil
+}
+
+// DeleteNamedRange is the resolver for the deleteNamedRange field.
+func (r *mutationResolver) DeleteNamedRange(ctx context.Context, id string) (*model.NamedRange, error) {
+	context := common.GetContext(ctx)
+	var namedRange model.NamedRange
+	err := context.Database.Where("id = ?", id).First(&namedRange).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting named range: %v", err)
+	}
+	err = namedRange.Delete(context)
+	if err != nil {
+		return nil, err
+	}
+	return &namedRange, nil
+}
+
+// ID is the resolver for the id field.
+func (r *namedRangeResolver) ID(ctx context.Context, obj *model.NamedRange) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+// GetNamedRanges is the resolver for the getNamedRanges field.
+func (r *queryResolver) GetNamedRanges(ctx context.Context, spreadsheetID string) ([]*model.NamedRange, error) {
+	context := common.GetContext(ctx)
+	var namedRanges []*model.NamedRange
+	err := context.Database.Where("spreadsheet_id = ?", spreadsheetID).Order("name").Find(&namedRanges).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting named ranges: %v", err)
+	}
+	return namedRanges, nil
+}
+
+// NamedRange returns generated.NamedRangeResolver implementation.
+func (r *Resolver) NamedRange() generated.NamedRangeResolver { return &namedRangeResolver{r} }
+
+type namedRangeResolver struct{ *Resolver }
@@ -0,0 +1,108 @@
+package resolvers
+
+import (
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestQueryResolver_GetNamedRanges(t *testing.T) {
+	t.Run("should return the names defined in a spreadsheet", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1 .+ ORDER BY name`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}).
+				AddRow(2, "1", "Revenue", "B2:B100").
+				AddRow(1, "1", "TaxRate", "Settings!B2"))
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetNamedRanges []struct {
+				ID        string
+				Name      string
+				Reference string
+			}
+		}{}
+
+		q := `query getNamedRanges {
+			getNamedRanges(spreadsheetId: "1") {
+				id
+				name
+				reference
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, 2, len(resp.GetNamedRanges))
+		assert.Equal(t, "1", resp.GetNamedRanges[1].ID)
+		assert.Equal(t, "TaxRate", resp.GetNamedRanges[1].Name)
+		assert.Equal(t, "Settings!B2", resp.GetNamedRanges[1].Reference)
+	})
+}
+
+func TestMutationResolver_CreateNamedRange(t *testing.T) {
+	t.Run("should fail to create a name that reads as a cell reference", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 10, 5))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			CreateNamedRange *model.NamedRange
+		}{}
+
+		q := `mutation createNamedRange {
+			createNamedRange(input: {
+				spreadsheetId: "1",
+				name: "TAX1",
+				reference: "B2"
+			}) {
+				id
+			}
+		}`
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "panic should have occurred")
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}()
+
+		gql.MustPost(q, &resp)
+		assert.Nil(t, resp.CreateNamedRange)
+	})
+}
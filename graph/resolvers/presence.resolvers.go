@@ -0,0 +1,97 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"log"
+	"time"
+)
+
+// UpdatePresence is the resolver for the updatePresence field.
+func (r *mutationResolver) UpdatePresence(ctx context.Context, input model.UpdatePresence) (*model.Presence, error) {
+	context := common.GetContext(ctx)
+	return model.SavePresence(context, input)
+}
+
+// UpdatedAt is the resolver for the updatedAt field.
+func (r *presenceResolver) UpdatedAt(ctx context.Context, obj *model.Presence) (string, error) {
+	return obj.UpdatedAt.Format(time.RFC3339), nil
+}
+
+// Presence is the resolver for the presence field.
+func (r *subscriptionResolver) Presence(ctx context.Context, spreadsheetID string, clientID string, name *string) (<-chan []*model.Presence, error) {
+	context := common.GetContext(ctx)
+	_, err := model.SavePresence(context, model.UpdatePresence{SpreadsheetID: spreadsheetID, ClientID: clientID, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	// subscribed after joining so the join is not reloaded once more, later changes are
+	events := context.Events.SubscribePresence(ctx, spreadsheetID)
+	presences, err := model.LoadPresences(context.Database, spreadsheetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan []*model.Presence)
+	go func() {
+		defer close(ch)
+		defer func() {
+			err := model.LeavePresence(context, spreadsheetID, clientID)
+			if err != nil {
+				log.Printf("error leaving spreadsheet %s as %s: %v", spreadsheetID, clientID, err)
+			}
+		}()
+		// refreshed along with the websocket keepalive, collaborators that stopped refreshing expire meanwhile
+		ticker := time.NewTicker(common.KeepAliveInterval)
+		defer ticker.Stop()
+
+		sent := presences
+		select {
+		case ch <- presences:
+		case <-ctx.Done():
+			return
+		}
+		for {
+			select {
+			case _, ok := <-events:
+				if !ok {
+					return
+				}
+			case <-ticker.C:
+				err := model.RefreshPresence(context.Database, spreadsheetID, clientID)
+				if err != nil {
+					addSubscriptionError(ctx, err)
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			presences, err := model.LoadPresences(context.Database, spreadsheetID)
+			if err != nil {
+				addSubscriptionError(ctx, err)
+				return
+			}
+			if model.SamePresences(presences, sent) {
+				continue
+			}
+			select {
+			case ch <- presences:
+				sent = presences
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// Presence returns generated.PresenceResolver implementation.
+func (r *Resolver) Presence() generated.PresenceResolver { return &presenceResolver{r} }
+
+type presenceResolver struct{ *Resolver }
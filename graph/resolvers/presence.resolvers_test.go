@@ -0,0 +1,166 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+var presenceColumns = []string{"spreadsheet_id", "client_id", "name", "sheet_id", "selection", "updated_at"}
+
+func TestMutationResolver_UpdatePresence(t *testing.T) {
+	t.Run("should keep the fields that are not given", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "presences" WHERE "presences"."spreadsheet_id" = \$1 AND "presences"."client_id" = \$2 LIMIT 1`).WithArgs("1", "alice").
+			WillReturnRows(sqlmock.NewRows(presenceColumns).AddRow("1", "alice", "Alice", "1", "A1", time.Now()))
+		mock.ExpectExec(`INSERT INTO "presences" .+ ON CONFLICT \("spreadsheet_id","client_id"\) DO UPDATE`).
+			WithArgs("1", "alice", "Alice", "1", "B2:D4", sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdatePresence struct {
+				Name      string
+				Selection string
+			}
+		}{}
+
+		q := `mutation updatePresence {
+			updatePresence(input: {spreadsheetId: "1", clientId: "alice", selection: "B2:D4"}) {
+				name
+				selection
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, "Alice", resp.UpdatePresence.Name)
+		assert.Equal(t, "B2:D4", resp.UpdatePresence.Selection)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should fail for a selection that is not a cell or range", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		var resp struct{}
+
+		q := `mutation updatePresence {
+			updatePresence(input: {spreadsheetId: "1", clientId: "alice", selection: "over there"}) {
+				clientId
+			}
+		}`
+		err := gql.Post(q, &resp)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSubscriptionResolver_Presence(t *testing.T) {
+	t.Run("should join, follow the other collaborators and leave", func(t *testing.T) {
+		// Create a test context with a mock database and an event bus
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		events := common.NewEventBus()
+		customCtx := &common.CustomContext{
+			Database: db,
+			Events:   events,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		// joining
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "presences" WHERE "presences"."spreadsheet_id" = \$1 AND "presences"."client_id" = \$2 LIMIT 1`).WithArgs("1", "alice").
+			WillReturnRows(sqlmock.NewRows(presenceColumns))
+		mock.ExpectExec(`INSERT INTO "presences"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(`SELECT \* FROM "presences" WHERE spreadsheet_id = \$1 AND updated_at > \$2 ORDER BY client_id`).WithArgs("1", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(presenceColumns).AddRow("1", "alice", "Alice", nil, nil, time.Now()))
+		// bob selects a cell
+		mock.ExpectQuery(`SELECT \* FROM "presences" WHERE spreadsheet_id = \$1 AND updated_at > \$2 ORDER BY client_id`).WithArgs("1", sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(presenceColumns).
+				AddRow("1", "alice", "Alice", nil, nil, time.Now()).
+				AddRow("1", "bob", "Bob", "1", "C3", time.Now()))
+		// leaving
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM "presences" WHERE spreadsheet_id = \$1 AND client_id = \$2`).WithArgs("1", "alice").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		gql := client.New(ctx)
+		sub := gql.Websocket(`subscription presence {
+			presence(spreadsheetId: "1", clientId: "alice", name: "Alice") {
+				clientId
+				selection
+			}
+		}`)
+
+		var resp struct {
+			Presence []struct {
+				ClientID  string
+				Selection *string
+			}
+		}
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, len(resp.Presence))
+		assert.Equal(t, "alice", resp.Presence[0].ClientID)
+
+		// cell events do not reach presence subscribers
+		events.Publish(common.Event{SpreadsheetID: "1", Version: 5})
+		events.Publish(common.Event{SpreadsheetID: "1", Presence: true})
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 2, len(resp.Presence))
+		assert.Equal(t, "C3", *resp.Presence[1].Selection)
+
+		// closing the subscription leaves the spreadsheet, which the other collaborators hear about
+		left := events.SubscribePresence(context.Background(), "1")
+		require.NoError(t, sub.Close())
+		select {
+		case <-left:
+		case <-time.After(time.Second):
+			t.Fatal("leaving was not published")
+		}
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
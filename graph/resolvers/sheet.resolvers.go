@@ -0,0 +1,166 @@
+package resolvers
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"math"
+	"strconv"
+)
+
+// CreateSheet is the resolver for the createSheet field.
+func (r *mutationResolver) CreateSheet(ctx context.Context, input model.NewSheet) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var spreadsheet model.Spreadsheet
+	err := context.Database.Where("id = ?", input.SpreadsheetID).First(&spreadsheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
+	}
+	sheet := &model.Sheet{
+		SpreadsheetID: input.SpreadsheetID,
+		Name:          input.Name,
+		RowCount:      input.RowCount,
+		ColumnCount:   input.ColumnCount,
+		// moveSheet clamps this to just after the last sheet
+		Position: math.MaxInt32,
+	}
+	if input.Position != nil {
+		sheet.Position = *input.Position
+	}
+	if input.ConflictPolicy != nil {
+		sheet.ConflictPolicy = *input.ConflictPolicy
+	}
+	err = model.CreateSheet(context, sheet)
+	if err != nil {
+		return nil, err
+	}
+	return sheet, nil
+}
+
+// UpdateSheet is the resolver for the updateSheet field.
+func (r *mutationResolver) UpdateSheet(ctx context.Context, id string, input model.UpdateSheet, dryRun *bool) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", id).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.Update(context, input, dryRun != nil && *dryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// DeleteSheet is the resolver for the deleteSheet field.
+func (r *mutationResolver) DeleteSheet(ctx context.Context, id string) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", id).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.Delete(context)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// InsertRows is the resolver for the insertRows field.
+func (r *mutationResolver) InsertRows(ctx context.Context, sheetID string, rowIndex int, count int) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", sheetID).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.InsertRows(context, rowIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// DeleteRows is the resolver for the deleteRows field.
+func (r *mutationResolver) DeleteRows(ctx context.Context, sheetID string, rowIndex int, count int) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", sheetID).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.DeleteRows(context, rowIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// InsertColumns is the resolver for the insertColumns field.
+func (r *mutationResolver) InsertColumns(ctx context.Context, sheetID string, columnIndex int, count int) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", sheetID).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.InsertColumns(context, columnIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// DeleteColumns is the resolver for the deleteColumns field.
+func (r *mutationResolver) DeleteColumns(ctx context.Context, sheetID string, columnIndex int, count int) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", sheetID).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	err = sheet.DeleteColumns(context, columnIndex, count)
+	if err != nil {
+		return nil, err
+	}
+	return &sheet, nil
+}
+
+// GetSheets is the resolver for the getSheets field.
+func (r *queryResolver) GetSheets(ctx context.Context, spreadsheetID string) ([]*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheets []*model.Sheet
+	err := context.Database.Where("spreadsheet_id = ?", spreadsheetID).Order("position").Find(&sheets).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheets: %v", err)
+	}
+	return sheets, nil
+}
+
+// GetSheet is the resolver for the getSheet field.
+func (r *queryResolver) GetSheet(ctx context.Context, id string) (*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheet model.Sheet
+	err := context.Database.Where("id = ?", id).First(&sheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheet: %v", err)
+	}
+	return &sheet, nil
+}
+
+// ID is the resolver for the id field.
+func (r *sheetResolver) ID(ctx context.Context, obj *model.Sheet) (string, error) {
+	return strconv.FormatUint(uint64(obj.ID), 10), nil
+}
+
+// Sheet returns generated.SheetResolver implementation.
+func (r *Resolver) Sheet() generated.SheetResolver { return &sheetResolver{r} }
+
+type sheetResolver struct{ *Resolver }
@@ -0,0 +1,175 @@
+package resolvers
+
+import (
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+	"github.com/vijaykramesh/gql-sheets/graph/generated"
+	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestQueryResolver_GetSheets(t *testing.T) {
+	t.Run("should return the sheets of a spreadsheet in order", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1 .+ ORDER BY position`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).
+				AddRow(1, "1", "Sheet1", 0, 10, 5).
+				AddRow(2, "1", "Q1 Budget", 1, 20, 3))
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			GetSheets []struct {
+				ID       string
+				Name     string
+				Position int
+				RowCount int
+			}
+		}{}
+
+		q := `query getSheets {
+			getSheets(spreadsheetId: "1") {
+				id
+				name
+				position
+				rowCount
+			}
+		}`
+		gql.MustPost(q, &resp)
+
+		assert.Equal(t, 2, len(resp.GetSheets))
+		assert.Equal(t, "2", resp.GetSheets[1].ID)
+		assert.Equal(t, "Q1 Budget", resp.GetSheets[1].Name)
+		assert.Equal(t, 1, resp.GetSheets[1].Position)
+		assert.Equal(t, 20, resp.GetSheets[1].RowCount)
+	})
+}
+
+func TestMutationResolver_CreateSheet(t *testing.T) {
+	t.Run("should fail to create a sheet with a name that is already used", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 10, 5))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5))
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			CreateSheet *model.Sheet
+		}{}
+
+		q := `mutation createSheet {
+			createSheet(input: {
+				spreadsheetId: "1",
+				name: "sheet1",
+				rowCount: 10,
+				columnCount: 5
+			}) {
+				id
+			}
+		}`
+		defer func() {
+			r := recover()
+			assert.NotNil(t, r, "panic should have occurred")
+			assert.NoError(t, mock.ExpectationsWereMet())
+		}()
+
+		gql.MustPost(q, &resp)
+		assert.Nil(t, resp.CreateSheet)
+	})
+
+	t.Run("should save a version and recalculate the formulas that already used the name", func(t *testing.T) {
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "row_count", "column_count"}).AddRow(1, 10, 5))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5))
+		mock.ExpectQuery(`SELECT "id" FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT coalesce\(max\(version\), 0\) FROM "spreadsheet_versions"`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1))
+		mock.ExpectExec(`INSERT INTO "spreadsheet_versions"`).WithArgs("1", 2, sqlmock.AnyArg(), "Created sheet Sheet2", true, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO "sheets"`).WillReturnRows(sqlmock.NewRows([]string{"id", "conflict_policy"}).AddRow(2, "REJECT_ON_CONFLICT"))
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).
+				AddRow(1, "1", "Sheet1", 0, 10, 5).
+				AddRow(2, "1", "Sheet2", 1, 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "computed_value", "computed_type", "row_index", "column_index", "version"}).
+				AddRow(1, "1", "1", "=Sheet2!A1+1", "#REF!", "ERROR", 0, 0, 1))
+		// the formula that was #REF! finds the new, empty sheet
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "1", "=Sheet2!A1+1", "1", "NUMBER", "", 0, 0, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		var resp struct {
+			CreateSheet struct {
+				ID string
+			}
+		}
+		gql.MustPost(`mutation createSheet {
+			createSheet(input: {
+				spreadsheetId: "1",
+				name: "Sheet2",
+				rowCount: 10,
+				columnCount: 5
+			}) {
+				id
+			}
+		}`, &resp)
+
+		assert.Equal(t, "2", resp.CreateSheet.ID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
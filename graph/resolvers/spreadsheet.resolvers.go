@@ -10,6 +10,7 @@ import (
 	"github.com/vijaykramesh/gql-sheets/graph/common"
 	"github.com/vijaykramesh/gql-sheets/graph/generated"
 	"github.com/vijaykramesh/gql-sheets/graph/model"
+	"gorm.io/gorm"
 	"strconv"
 	"time"
 )
@@ -18,19 +19,51 @@ import (
 func (r *mutationResolver) CreateSpreadsheet(ctx context.Context, input model.NewSpreadsheet) (*model.Spreadsheet, error) {
 	context := common.GetContext(ctx)
 	spreadsheet := &model.Spreadsheet{
-		Name:        input.Name,
-		RowCount:    input.RowCount,
-		ColumnCount: input.ColumnCount,
+		Name:                 input.Name,
+		RowCount:             input.RowCount,
+		ColumnCount:          input.ColumnCount,
+		MaxIterations:        model.DefaultMaxIterations,
+		ConvergenceThreshold: model.DefaultConvergenceThreshold,
 	}
-	err := context.Database.Create(&spreadsheet).Error
+	if input.IterativeCalculation != nil {
+		spreadsheet.IterativeCalculation = *input.IterativeCalculation
+	}
+	if input.MaxIterations != nil {
+		spreadsheet.MaxIterations = *input.MaxIterations
+	}
+	if input.ConvergenceThreshold != nil {
+		spreadsheet.ConvergenceThreshold = *input.ConvergenceThreshold
+	}
+	err := model.ValidateCalculationSettings(*spreadsheet)
 	if err != nil {
-		return nil, fmt.Errorf("error creating spreadsheet: %v", err)
+		return nil, err
+	}
+	// every spreadsheet starts with one sheet of its size
+	err = context.Database.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&spreadsheet).Error
+		if err != nil {
+			return fmt.Errorf("error creating spreadsheet: %v", err)
+		}
+		sheet := &model.Sheet{
+			SpreadsheetID: strconv.FormatUint(uint64(spreadsheet.ID), 10),
+			Name:          model.DefaultSheetName,
+			RowCount:      spreadsheet.RowCount,
+			ColumnCount:   spreadsheet.ColumnCount,
+		}
+		err = tx.Create(sheet).Error
+		if err != nil {
+			return fmt.Errorf("error creating sheet: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return spreadsheet, nil
 }
 
 // UpdateSpreadsheet is the resolver for the updateSpreadsheet field.
-func (r *mutationResolver) UpdateSpreadsheet(ctx context.Context, id string, input model.UpdateSpreadsheet) (*model.Spreadsheet, error) {
+func (r *mutationResolver) UpdateSpreadsheet(ctx context.Context, id string, input model.UpdateSpreadsheet, dryRun *bool) (*model.Spreadsheet, error) {
 	context := common.GetContext(ctx)
 	var spreadsheet model.Spreadsheet
 	err := context.Database.Where("id = ?", id).First(&spreadsheet).Error
@@ -46,12 +79,49 @@ func (r *mutationResolver) UpdateSpreadsheet(ctx context.Context, id string, inp
 	if input.ColumnCount != nil {
 		spreadsheet.ColumnCount = *input.ColumnCount
 	}
+	if input.IterativeCalculation != nil {
+		spreadsheet.IterativeCalculation = *input.IterativeCalculation
+	}
+	if input.MaxIterations != nil {
+		spreadsheet.MaxIterations = *input.MaxIterations
+	}
+	if input.ConvergenceThreshold != nil {
+		spreadsheet.ConvergenceThreshold = *input.ConvergenceThreshold
+	}
+	if input.IterativeCalculation != nil || input.MaxIterations != nil || input.ConvergenceThreshold != nil {
+		err = model.ValidateCalculationSettings(spreadsheet)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	// TODO: delete any cells that are out of bounds
-
-	err = context.Database.Save(&spreadsheet).Error
+	err = context.Database.Transaction(func(tx *gorm.DB) error {
+		if input.RowCount != nil || input.ColumnCount != nil {
+			// the size of the spreadsheet is the size of its first sheet, shrinking it empties the cells outside
+			sheet, err := model.DefaultSheet(tx, id)
+			if err != nil {
+				return err
+			}
+			err = sheet.Update(&common.CustomContext{Database: tx, Author: context.Author}, model.UpdateSheet{RowCount: input.RowCount, ColumnCount: input.ColumnCount}, dryRun != nil && *dryRun)
+			if err != nil {
+				return err
+			}
+			spreadsheet.OutOfBoundsCells = sheet.OutOfBoundsCells
+		}
+		if dryRun != nil && *dryRun {
+			return nil
+		}
+		err := tx.Save(&spreadsheet).Error
+		if err != nil {
+			return fmt.Errorf("error updating spreadsheet: %v", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error updating spreadsheet: %v", err)
+		return nil, err
+	}
+	if (input.RowCount != nil || input.ColumnCount != nil) && (dryRun == nil || !*dryRun) {
+		context.Events.Publish(common.Event{SpreadsheetID: id})
 	}
 	return &spreadsheet, nil
 }
@@ -59,17 +129,20 @@ func (r *mutationResolver) UpdateSpreadsheet(ctx context.Context, id string, inp
 // RevertSpreadsheet is the resolver for the revertSpreadsheet field.
 func (r *mutationResolver) RevertSpreadsheet(ctx context.Context, id string, version string) (*model.Spreadsheet, error) {
 	context := common.GetContext(ctx)
-	var spreadsheet model.Spreadsheet
-	err := context.Database.Where("id = ?", id).First(&spreadsheet).Error
+	revertTo, err := strconv.ParseUint(version, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
+		return nil, fmt.Errorf("invalid version %s", version)
 	}
-	// delete all cells with version > version
-	err = context.Database.Where("spreadsheet_id = ? AND version > ?", id, version).Delete(&model.Cell{}).Error
+	_, err = model.RevertSpreadsheet(context, id, revertTo)
 	if err != nil {
-		return nil, fmt.Errorf("error deleting cells: %v", err)
+		return nil, err
+	}
+	// the revert may have given the spreadsheet back an earlier size
+	var spreadsheet model.Spreadsheet
+	err = context.Database.Where("id = ?", id).First(&spreadsheet).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting spreadsheet: %v", err)
 	}
-	// return
 	return &spreadsheet, nil
 }
 
@@ -98,14 +171,7 @@ func (r *queryResolver) GetSpreadsheet(ctx context.Context, id string) (*model.S
 // GetVersions is the resolver for the getVersions field.
 func (r *queryResolver) GetVersions(ctx context.Context, id string) ([]*model.Version, error) {
 	context := common.GetContext(ctx)
-	var cells []*model.Cell
-	err := context.Database.Where("spreadsheet_id = ?", id).Find(&cells).Error
-	if err != nil {
-		return nil, fmt.Errorf("error getting cells: %v", err)
-	}
-	versions := buildLatestVersionSlice(cells)
-
-	return versions, nil
+	return model.LoadVersions(context.Database, id)
 }
 
 // ID is the resolver for the id field.
@@ -120,41 +186,65 @@ func (r *spreadsheetResolver) ID(ctx context.Context, obj *model.Spreadsheet) (s
 	return strconv.FormatUint(uint64(spreadsheet.ID), 10), nil
 }
 
+// Sheets is the resolver for the sheets field.
+func (r *spreadsheetResolver) Sheets(ctx context.Context, obj *model.Spreadsheet) ([]*model.Sheet, error) {
+	context := common.GetContext(ctx)
+	var sheets []*model.Sheet
+	err := context.Database.Where("spreadsheet_id = ?", obj.ID).Order("position").Find(&sheets).Error
+	if err != nil {
+		return nil, fmt.Errorf("error getting sheets: %v", err)
+	}
+	return sheets, nil
+}
+
 // GetVersions is the resolver for the getVersions field.
 func (r *subscriptionResolver) GetVersions(ctx context.Context, id string) (<-chan []*model.Version, error) {
+	context := common.GetContext(ctx)
+	events := context.Events.Subscribe(ctx, id)
+	versions, err := model.LoadVersions(context.Database, id)
+	if err != nil {
+		return nil, err
+	}
+
 	ch := make(chan []*model.Version)
 	go func() {
+		defer close(ch)
 		for {
-			context := common.GetContext(ctx)
-			time.Sleep(1 * time.Second)
-			var cells []*model.Cell
-			err := context.Database.Where("spreadsheet_id = ?", id).Find(&cells).Error
+			select {
+			case ch <- versions:
+			case <-ctx.Done():
+				return
+			}
+			// the events channel is closed once the client disconnects
+			if _, ok := <-events; !ok {
+				return
+			}
+			versions, err = model.LoadVersions(context.Database, id)
 			if err != nil {
-				panic(fmt.Errorf("error getting cells: %v", err))
+				addSubscriptionError(ctx, err)
+				return
 			}
-			versions := buildLatestVersionSlice(cells)
-			ch <- versions
 		}
 	}()
 	return ch, nil
 }
-func buildLatestVersionSlice(cells []*model.Cell) []*model.Version {
-	seenVersions := make(map[uint64]bool)
-	var versions []*model.Version
-	for _, cell := range cells {
-		if _, ok := seenVersions[cell.Version]; ok {
-			continue
-		}
-		seenVersions[cell.Version] = true
-		version := &model.Version{
-			Version: strconv.FormatUint(cell.Version, 10),
-		}
-		versions = append(versions, version)
-	}
-	return versions
+
+// Version is the resolver for the version field.
+func (r *versionResolver) Version(ctx context.Context, obj *model.Version) (string, error) {
+	return strconv.FormatUint(obj.Version, 10), nil
+}
+
+// CreatedAt is the resolver for the createdAt field.
+func (r *versionResolver) CreatedAt(ctx context.Context, obj *model.Version) (string, error) {
+	return obj.CreatedAt.Format(time.RFC3339), nil
 }
 
 // Spreadsheet returns generated.SpreadsheetResolver implementation.
 func (r *Resolver) Spreadsheet() generated.SpreadsheetResolver { return &spreadsheetResolver{r} }
 
 type spreadsheetResolver struct{ *Resolver }
+
+// Version returns generated.VersionResolver implementation.
+func (r *Resolver) Version() generated.VersionResolver { return &versionResolver{r} }
+
+type versionResolver struct{ *Resolver }
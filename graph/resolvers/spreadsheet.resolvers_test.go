@@ -1,6 +1,7 @@
 package resolvers
 
 import (
+	"fmt"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/stretchr/testify/require"
@@ -11,8 +12,19 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"testing"
+	"time"
 )
 
+// expectNextVersion expects a change to a spreadsheet to be saved as version, after locking the spreadsheet.
+func expectNextVersion(mock sqlmock.Sqlmock, spreadsheetID string, version uint64) {
+	mock.ExpectQuery(`SELECT "id" FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs(spreadsheetID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(spreadsheetID))
+	mock.ExpectQuery(`SELECT coalesce\(max\(version\), 0\) FROM "spreadsheet_versions" WHERE spreadsheet_id = \$1`).WithArgs(spreadsheetID).
+		WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(version - 1))
+	mock.ExpectExec(`INSERT INTO "spreadsheet_versions"`).WithArgs(spreadsheetID, version, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
 func TestMutationResolver_CreateSpreadsheet(t *testing.T) {
 	t.Run("should create a spreadsheet", func(t *testing.T) {
 		mockDb, mock, _ := sqlmock.New()
@@ -22,7 +34,8 @@ func TestMutationResolver_CreateSpreadsheet(t *testing.T) {
 		})
 		mock.ExpectBegin()
 
-		mock.ExpectQuery(`INSERT INTO .+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`INSERT INTO .+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`INSERT INTO "sheets" .+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "Sheet1", 0, 10, 5, "REJECT_ON_CONFLICT").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 		mock.ExpectCommit()
 
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -67,7 +80,16 @@ func TestMutationResolver_UpdateSpreadsheet(t *testing.T) {
 
 		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "rowCount", "columnCount"}).AddRow(1, "Test Spreadsheet", 10, 5))
 		mock.ExpectBegin()
-		mock.ExpectExec(`UPDATE .+ SET .+ WHERE .+ "id" = \$\d+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		sheetRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5)
+		}
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows())
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows())
+		expectNextVersion(mock, "1", 1)
+		mock.ExpectExec(`INSERT INTO "sheet_resizes"`).WithArgs("1", 1, 1, 10, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE "sheets" SET .+ WHERE .+ "id" = \$\d+`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE .+ SET .+ WHERE .+ "id" = \$\d+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
 		mock.ExpectCommit()
 
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -101,6 +123,146 @@ func TestMutationResolver_UpdateSpreadsheet(t *testing.T) {
 		require.Equal(t, 20, resp.UpdateSpreadsheet.RowCount)
 		require.Equal(t, 8, resp.UpdateSpreadsheet.ColumnCount)
 	})
+
+	t.Run("should list the cells a dry run of shrinking would remove without saving", func(t *testing.T) {
+		mockDb, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDb,
+			DriverName: "postgres",
+		})
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectBegin()
+		sheetRows := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 10, 5)
+		}
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows())
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows())
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "sheet_id", "row_index", "column_index", "raw_value", "version"}).
+				AddRow("1", "1", 0, 0, "1", 1).
+				AddRow("1", "1", 8, 0, "2", 1).
+				AddRow("1", "1", 9, 0, "", 2))
+		mock.ExpectCommit()
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateSpreadsheet struct {
+				RowCount         int
+				OutOfBoundsCells []struct {
+					RowIndex int
+					RawValue string
+				}
+			}
+		}{}
+
+		q := `mutation updateSpreadsheet {
+			updateSpreadsheet(id: "1", input: {rowCount: 5}, dryRun: true) {
+				rowCount
+				outOfBoundsCells {
+					rowIndex
+					rawValue
+				}
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		require.Equal(t, 5, resp.UpdateSpreadsheet.RowCount)
+		require.Equal(t, 1, len(resp.UpdateSpreadsheet.OutOfBoundsCells))
+		require.Equal(t, 8, resp.UpdateSpreadsheet.OutOfBoundsCells[0].RowIndex)
+		require.Equal(t, "2", resp.UpdateSpreadsheet.OutOfBoundsCells[0].RawValue)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should empty the cells left outside a shrunk sheet and recalculate their dependents", func(t *testing.T) {
+		mockDb, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDb,
+			DriverName: "postgres",
+		})
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectBegin()
+		sheetRows := func(rowCount int) *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, rowCount, 5)
+		}
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows(10))
+		mock.ExpectExec(`SAVEPOINT`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows(10))
+		mock.ExpectQuery(`SELECT "id" FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT coalesce\(max\(version\), 0\) FROM "spreadsheet_versions"`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(1))
+		mock.ExpectExec(`INSERT INTO "spreadsheet_versions"`).WithArgs("1", 2, sqlmock.AnyArg(), "Resized sheet Sheet1 to 5 rows and 5 columns", false, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// the previous size is kept for reverting
+		mock.ExpectExec(`INSERT INTO "sheet_resizes"`).WithArgs("1", 2, 1, 10, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE "sheets" SET .+ WHERE .+ "id" = \$\d+`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(sheetRows(5))
+		mock.ExpectQuery(`SELECT \* FROM "named_ranges" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "reference"}))
+		mock.ExpectQuery(`SELECT \* FROM "cells"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "computed_value", "computed_type", "row_index", "column_index", "version"}).
+				AddRow(1, "1", "1", "1", "1", "NUMBER", 0, 0, 1).
+				AddRow(2, "1", "1", "2", "2", "NUMBER", 8, 0, 1).
+				AddRow(3, "1", "1", "=SUM(A:A)", "3", "NUMBER", 0, 1, 1))
+		// the cell outside is saved empty, and the sum no longer counts it
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "1", "", "", "EMPTY", "", 8, 0, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), "1", "1", "=SUM(A:A)", "1", "NUMBER", "", 0, 1, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+		mock.ExpectExec(`UPDATE "spreadsheets" SET .+ WHERE .+ "id" = \$\d+`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			UpdateSpreadsheet struct {
+				RowCount         int
+				OutOfBoundsCells []struct {
+					RowIndex int
+					RawValue string
+				}
+			}
+		}{}
+
+		q := `mutation updateSpreadsheet {
+			updateSpreadsheet(id: "1", input: {rowCount: 5}) {
+				rowCount
+				outOfBoundsCells {
+					rowIndex
+					rawValue
+				}
+			}
+		}`
+
+		gql.MustPost(q, &resp)
+
+		require.Equal(t, 5, resp.UpdateSpreadsheet.RowCount)
+		require.Equal(t, 1, len(resp.UpdateSpreadsheet.OutOfBoundsCells))
+		require.Equal(t, 8, resp.UpdateSpreadsheet.OutOfBoundsCells[0].RowIndex)
+		require.Equal(t, "2", resp.UpdateSpreadsheet.OutOfBoundsCells[0].RawValue)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
 }
 
 func TestQueryResolver_Spreadsheets(t *testing.T) {
@@ -235,11 +397,12 @@ func TestQueryResolver_GetVersions(t *testing.T) {
 			Conn:       mockDB,
 			DriverName: "postgres",
 		})
-		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").
-			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "version"}).
-				AddRow(1, "1", 1).
-				AddRow(2, "1", 2).
-				AddRow(3, "1", 3))
+		createdAt := time.Date(2023, 7, 1, 12, 30, 0, 0, time.UTC)
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheet_versions" WHERE spreadsheet_id = \$1 ORDER BY version`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "author", "summary", "created_at"}).
+				AddRow("1", 1, "alice", "Set Sheet1!A1 to 1", createdAt).
+				AddRow("1", 2, nil, "Inserted 1 rows before row 1 of Sheet1", createdAt).
+				AddRow("1", 3, "bob", "Reverted to version 1", createdAt))
 
 		// Create a test context with the mocked database
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -254,13 +417,21 @@ func TestQueryResolver_GetVersions(t *testing.T) {
 
 		// Define the response structure
 		resp := struct {
-			GetVersions []*model.Version
+			GetVersions []struct {
+				Version   string
+				Author    *string
+				Summary   string
+				CreatedAt string
+			}
 		}{}
 
 		// Construct the GraphQL query
 		q := `query getVersions {
 			getVersions(id: "1") {
 				version
+				author
+				summary
+				createdAt
 			}
 		}`
 
@@ -271,10 +442,16 @@ func TestQueryResolver_GetVersions(t *testing.T) {
 		require.NotNil(t, resp.GetVersions)
 		require.Equal(t, 3, len(resp.GetVersions))
 		require.Equal(t, "1", resp.GetVersions[0].Version)
+		require.Equal(t, "alice", *resp.GetVersions[0].Author)
+		require.Equal(t, "Set Sheet1!A1 to 1", resp.GetVersions[0].Summary)
+		require.Equal(t, "2023-07-01T12:30:00Z", resp.GetVersions[0].CreatedAt)
 		require.Equal(t, "2", resp.GetVersions[1].Version)
+		require.Nil(t, resp.GetVersions[1].Author)
 		require.Equal(t, "3", resp.GetVersions[2].Version)
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
 func TestSubscriptionResolver_GetVersions(t *testing.T) {
 	t.Run("should receive versions for a specific spreadsheet", func(t *testing.T) {
 		// Create a test context with a mock database
@@ -295,7 +472,9 @@ func TestSubscriptionResolver_GetVersions(t *testing.T) {
 
 		// Define the response structure
 		resp := struct {
-			GetVersions []*model.Version
+			GetVersions []struct {
+				Version string
+			}
 		}{}
 
 		// Construct the GraphQL subscription query
@@ -305,8 +484,17 @@ func TestSubscriptionResolver_GetVersions(t *testing.T) {
 			}
 		}`
 
+		// Prepare the mocked database rows
+		rows := sqlmock.NewRows([]string{"id", "spreadsheet_id", "version"}).
+			AddRow(1, "1", 1).
+			AddRow(2, "1", 2).
+			AddRow(3, "1", 3)
+
+		// Set up the mock expectations, the versions are loaded as soon as the subscription starts
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(rows)
+
 		// Create a channel to receive the versions
-		versionsCh := make(chan []*model.Version)
+		versionsCh := make(chan []struct{ Version string })
 
 		// Start the subscription in a goroutine
 		go func() {
@@ -316,20 +504,7 @@ func TestSubscriptionResolver_GetVersions(t *testing.T) {
 		}()
 
 		// Prepare the expected versions
-		expectedVersions := []*model.Version{
-			{Version: "1"},
-			{Version: "2"},
-			{Version: "3"},
-		}
-
-		// Prepare the mocked database rows
-		rows := sqlmock.NewRows([]string{"id", "spreadsheet_id", "version"}).
-			AddRow(1, "1", 1).
-			AddRow(2, "1", 2).
-			AddRow(3, "1", 3)
-
-		// Set up the mock expectations
-		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").WillReturnRows(rows)
+		expectedVersions := []string{"1", "2", "3"}
 
 		// Wait for the versions to be received
 		receivedVersions := <-versionsCh
@@ -338,8 +513,64 @@ func TestSubscriptionResolver_GetVersions(t *testing.T) {
 		require.NotNil(t, receivedVersions)
 		require.Equal(t, len(expectedVersions), len(receivedVersions))
 		for i, expectedVersion := range expectedVersions {
-			require.Equal(t, expectedVersion.Version, receivedVersions[i].Version)
+			require.Equal(t, expectedVersion, receivedVersions[i].Version)
+		}
+	})
+}
+
+func TestSubscriptionResolver_GetVersionsEvents(t *testing.T) {
+	t.Run("should reload the versions when the spreadsheet changes", func(t *testing.T) {
+		// Create a test context with a mock database and an event bus
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		events := common.NewEventBus()
+		customCtx := &common.CustomContext{
+			Database: db,
+			Events:   events,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "version"}).AddRow(1, "1", 1))
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "version"}).AddRow(1, "1", 1).AddRow(2, "1", 2))
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnError(fmt.Errorf("connection lost"))
+
+		gql := client.New(ctx)
+		sub := gql.Websocket(`subscription getVersions {
+			getVersions(id: "1") {
+				version
+			}
+		}`)
+		defer sub.Close()
+
+		var resp struct {
+			GetVersions []struct {
+				Version string
+			}
 		}
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, len(resp.GetVersions))
+
+		// events for other spreadsheets are not delivered
+		events.Publish(common.Event{SpreadsheetID: "2", Version: 2})
+		events.Publish(common.Event{SpreadsheetID: "1", Version: 2})
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 2, len(resp.GetVersions))
+		require.Equal(t, "2", resp.GetVersions[1].Version)
+
+		// a failing reload ends the subscription with an error instead of crashing the server
+		events.Publish(common.Event{SpreadsheetID: "1", Version: 3})
+		err := sub.Next(&resp)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "connection lost")
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
 
@@ -352,12 +583,25 @@ func TestMutationResolver_RevertSpreadsheet(t *testing.T) {
 			Conn:       mockDB,
 			DriverName: "postgres",
 		})
-		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
-			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
 		mock.ExpectBegin()
-		mock.ExpectExec(`UPDATE .+ SET "deleted_at"=\$1 .+`).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectNextVersion(mock, "1", 5)
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheet_versions" WHERE spreadsheet_id = \$1 AND version > \$2 AND structural ORDER BY version LIMIT 1`).WithArgs("1", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "summary", "structural"}))
+		mock.ExpectQuery(`SELECT \* FROM "sheet_resizes" WHERE spreadsheet_id = \$1 AND version > \$2 ORDER BY version`).WithArgs("1", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "sheet_id", "row_count", "column_count"}))
+		mock.ExpectExec(`UPDATE "cells" SET "deleted_at"=\$1,"deleted_version"=\$2,"updated_at"=\$3 WHERE \(spreadsheet_id = \$4 AND version > \$5\) AND "cells"."deleted_at" IS NULL`).
+			WithArgs(sqlmock.AnyArg(), 5, sqlmock.AnyArg(), "1", 2).WillReturnResult(sqlmock.NewResult(1, 1))
+		// version 4 reverted to version 1 and deleted B1 saved at version 2, reverting to version 2 saves it again
+		mock.ExpectQuery(`SELECT DISTINCT ON \(sheet_id, row_index, column_index\) \* FROM "cells" WHERE spreadsheet_id = \$1 AND version <= \$2 AND \(deleted_version IS NULL OR deleted_version > \$3\) ORDER BY sheet_id, row_index, column_index, version desc`).
+			WithArgs("1", 2, 2).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "computed_value", "computed_type", "row_index", "column_index", "version", "deleted_at", "deleted_version"}).
+				AddRow(1, "1", "1", "1", "1", "NUMBER", 0, 0, 1, nil, nil).
+				AddRow(2, "1", "1", "=A1+1", "2", "NUMBER", 0, 1, 2, time.Now(), 4))
+		mock.ExpectQuery(`INSERT INTO "cells"`).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, "1", "1", "=A1+1", "2", "NUMBER", "", 0, 1, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3))
 		mock.ExpectCommit()
-		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs(1).
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
 			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
 		// Create a test context with the mocked database
 		db, _ := gorm.Open(dialector, &gorm.Config{})
@@ -392,5 +636,128 @@ func TestMutationResolver_RevertSpreadsheet(t *testing.T) {
 		require.Equal(t, "Test Spreadsheet", resp.RevertSpreadsheet.Name)
 		require.Equal(t, 10, resp.RevertSpreadsheet.RowCount)
 		require.Equal(t, 5, resp.RevertSpreadsheet.ColumnCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should give a shrunk sheet back its size and the cells it removed", func(t *testing.T) {
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		expectNextVersion(mock, "1", 3)
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheet_versions" WHERE spreadsheet_id = \$1 AND version > \$2 AND structural ORDER BY version LIMIT 1`).WithArgs("1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "summary", "structural"}))
+		// version 2 shrunk the sheet from 10 rows to 5
+		mock.ExpectQuery(`SELECT \* FROM "sheet_resizes" WHERE spreadsheet_id = \$1 AND version > \$2 ORDER BY version`).WithArgs("1", 1).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "sheet_id", "row_count", "column_count"}).AddRow("1", 2, 1, 10, 5))
+		mock.ExpectQuery(`SELECT \* FROM "sheets" WHERE spreadsheet_id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "name", "position", "row_count", "column_count"}).AddRow(1, "1", "Sheet1", 0, 5, 5))
+		mock.ExpectExec(`INSERT INTO "sheet_resizes"`).WithArgs("1", 3, 1, 5, 5).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE "sheets" SET "column_count"=\$1,"row_count"=\$2,"updated_at"=\$3 WHERE id = \$4`).
+			WithArgs(5, 10, sqlmock.AnyArg(), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE "spreadsheets" SET "column_count"=\$1,"row_count"=\$2,"updated_at"=\$3 WHERE id = \$4`).
+			WithArgs(5, 10, sqlmock.AnyArg(), "1").WillReturnResult(sqlmock.NewResult(0, 1))
+		// deleting the emptied cells the shrink saved brings back the cells from before it
+		mock.ExpectExec(`UPDATE "cells" SET "deleted_at"=\$1,"deleted_version"=\$2,"updated_at"=\$3 WHERE \(spreadsheet_id = \$4 AND version > \$5\) AND "cells"."deleted_at" IS NULL`).
+			WithArgs(sqlmock.AnyArg(), 3, sqlmock.AnyArg(), "1", 1).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(`SELECT DISTINCT ON \(sheet_id, row_index, column_index\) \* FROM "cells"`).WithArgs("1", 1, 1).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "spreadsheet_id", "sheet_id", "raw_value", "row_index", "column_index", "version"}).
+				AddRow(1, "1", "1", "2", 8, 0, 1))
+		mock.ExpectCommit()
+		mock.ExpectQuery(`SELECT \* FROM .+ WHERE id = \$1`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "row_count", "column_count"}).AddRow(1, "Test Spreadsheet", 10, 5))
+
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		resp := struct {
+			RevertSpreadsheet *model.Spreadsheet
+		}{}
+		gql.MustPost(`mutation revertSpreadsheet {
+			revertSpreadsheet(id: "1", version: "1") {
+				rowCount
+			}
+		}`, &resp)
+
+		require.Equal(t, 10, resp.RevertSpreadsheet.RowCount)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should record who reverted and refuse versions that were not saved yet", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT "id" FROM "spreadsheets" WHERE id = \$1 .+ FOR UPDATE`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT coalesce\(max\(version\), 0\) FROM "spreadsheet_versions"`).WithArgs("1").
+			WillReturnRows(sqlmock.NewRows([]string{"coalesce"}).AddRow(4))
+		mock.ExpectExec(`INSERT INTO "spreadsheet_versions"`).WithArgs("1", 5, "alice", "Reverted to version 9", false, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		var resp struct{}
+		err := gql.Post(`mutation revertSpreadsheet {
+			revertSpreadsheet(id: "1", version: "9") {
+				name
+			}
+		}`, &resp, client.AddHeader(common.AuthorHeader, "alice"))
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "version 9 not found in spreadsheet 1")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("should refuse to revert past a change to the structure of the spreadsheet", func(t *testing.T) {
+		// Mock the database and prepare expectations
+		mockDB, mock, _ := sqlmock.New()
+		dialector := postgres.New(postgres.Config{
+			Conn:       mockDB,
+			DriverName: "postgres",
+		})
+		mock.ExpectBegin()
+		expectNextVersion(mock, "1", 5)
+		mock.ExpectQuery(`SELECT \* FROM "spreadsheet_versions" WHERE spreadsheet_id = \$1 AND version > \$2 AND structural ORDER BY version LIMIT 1`).WithArgs("1", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"spreadsheet_id", "version", "summary", "structural"}).AddRow(1, 3, "Renamed sheet Sheet1 to Budget", true))
+		mock.ExpectRollback()
+
+		// Create a test context with the mocked database
+		db, _ := gorm.Open(dialector, &gorm.Config{})
+		customCtx := &common.CustomContext{
+			Database: db,
+		}
+		srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{}}))
+		ctx := common.CreateContext(customCtx, srv)
+
+		gql := client.New(ctx)
+		var resp struct{}
+		err := gql.Post(`mutation revertSpreadsheet {
+			revertSpreadsheet(id: "1", version: "2") {
+				name
+			}
+		}`, &resp)
+
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "cannot revert to version 2, version 3 changed the structure of the spreadsheet: Renamed sheet Sheet1 to Budget")
+		require.NoError(t, mock.ExpectationsWereMet())
 	})
 }
@@ -0,0 +1,20 @@
+package resolvers
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vijaykramesh/gql-sheets/graph/common"
+)
+
+// addSubscriptionError sends err to the client of a subscription, which ends once its channel is closed. Only
+// websocket subscriptions stay open past their first result, other transports have already responded and have
+// nowhere to send err, so it is dropped.
+func addSubscriptionError(ctx context.Context, err error) {
+	customContext := common.GetContext(ctx)
+	if !graphql.HasOperationContext(ctx) || customContext == nil || !customContext.Websocket {
+		return
+	}
+	transport.AddSubscriptionError(ctx, gqlerror.Errorf("%v", err))
+}
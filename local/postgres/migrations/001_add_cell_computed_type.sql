@@ -0,0 +1 @@
+alter table cells add column computed_type text;
@@ -0,0 +1 @@
+alter table cells add column error_message text;
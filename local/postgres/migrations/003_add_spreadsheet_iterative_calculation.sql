@@ -0,0 +1,3 @@
+alter table spreadsheets add column iterative_calculation boolean not null default false;
+alter table spreadsheets add column max_iterations int not null default 100;
+alter table spreadsheets add column convergence_threshold double precision not null default 0.001;
@@ -0,0 +1,17 @@
+create table sheets (
+    id serial primary key,
+    spreadsheet_id int not null references spreadsheets(id),
+    name text not null,
+    position int not null,
+    row_count int not null,
+    column_count int not null,
+    created_at timestamp default current_timestamp,
+    updated_at timestamp default current_timestamp,
+    deleted_at timestamp
+);
+-- every existing spreadsheet gets a first sheet holding its cells
+insert into sheets (spreadsheet_id, name, position, row_count, column_count)
+    select id, 'Sheet1', 0, row_count, column_count from spreadsheets;
+alter table cells add column sheet_id int references sheets(id);
+update cells set sheet_id = sheets.id from sheets where sheets.spreadsheet_id = cells.spreadsheet_id;
+alter table cells alter column sheet_id set not null;
@@ -0,0 +1,9 @@
+create table named_ranges (
+    id serial primary key,
+    spreadsheet_id int not null references spreadsheets(id),
+    name text not null,
+    reference text not null,
+    created_at timestamp default current_timestamp,
+    updated_at timestamp default current_timestamp,
+    deleted_at timestamp
+);
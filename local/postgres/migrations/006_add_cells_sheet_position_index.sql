@@ -0,0 +1,2 @@
+-- serves getCellsInRange, the latest version of each cell comes first within its position
+create index cells_sheet_position on cells (sheet_id, row_index, column_index, version desc) where deleted_at is null;
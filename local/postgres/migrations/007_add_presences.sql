@@ -0,0 +1,9 @@
+create table presences (
+    spreadsheet_id int not null references spreadsheets(id),
+    client_id text not null,
+    name text,
+    sheet_id int references sheets(id),
+    selection text,
+    updated_at timestamp default current_timestamp,
+    primary key (spreadsheet_id, client_id)
+);
@@ -0,0 +1 @@
+alter table sheets add column conflict_policy text not null default 'REJECT_ON_CONFLICT';
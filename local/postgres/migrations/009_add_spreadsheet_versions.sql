@@ -0,0 +1,16 @@
+create table spreadsheet_versions (
+    spreadsheet_id int not null references spreadsheets(id),
+    version bigint not null,
+    author text,
+    summary text not null,
+    created_at timestamp default current_timestamp,
+    primary key (spreadsheet_id, version)
+);
+
+alter table cells add column deleted_version bigint;
+
+-- versions so far were the time of the change in milliseconds, recording them lets new versions count up from the latest
+insert into spreadsheet_versions (spreadsheet_id, version, summary, created_at)
+select distinct spreadsheet_id, version, 'Saved before versions were recorded', to_timestamp(version / 1000.0)
+from cells
+where version is not null;
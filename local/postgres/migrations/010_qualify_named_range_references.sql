@@ -0,0 +1,10 @@
+-- names without a sheet referred to whichever sheet came first, pin them to the sheet that is first now
+update named_ranges set reference = (
+    select case when s.name ~ '^[A-Za-z_][A-Za-z0-9_.]*$' then s.name else '''' || replace(s.name, '''', '''''') || '''' end
+    from sheets s
+    where s.spreadsheet_id = named_ranges.spreadsheet_id and s.deleted_at is null
+    order by s.position
+    limit 1
+) || '!' || reference
+where reference not like '%!%'
+  and exists (select 1 from sheets s where s.spreadsheet_id = named_ranges.spreadsheet_id and s.deleted_at is null);
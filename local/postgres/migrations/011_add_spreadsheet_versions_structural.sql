@@ -0,0 +1,2 @@
+-- changes to sheets and names are not versioned, spreadsheets cannot be reverted past them
+alter table spreadsheet_versions add column structural boolean not null default false;
@@ -0,0 +1,10 @@
+-- resizing a sheet records its previous size, which reverting to an earlier version restores. Resizes saved before
+-- this stay structural, their previous sizes were not recorded.
+create table sheet_resizes (
+    spreadsheet_id int not null references spreadsheets(id),
+    version bigint not null,
+    sheet_id int not null references sheets(id),
+    row_count int not null,
+    column_count int not null,
+    primary key (spreadsheet_id, version, sheet_id)
+);
@@ -3,20 +3,76 @@ create table spreadsheets (
                                 name text not null,
                                 row_count int not null,
                                 column_count int not null,
+                                iterative_calculation boolean not null default false,
+                                max_iterations int not null default 100,
+                                convergence_threshold double precision not null default 0.001,
                                 created_at timestamp default current_timestamp,
                                 updated_at timestamp default current_timestamp,
                                 deleted_at timestamp
 );
 
+create table sheets (
+                              id serial primary key,
+                              spreadsheet_id int not null references spreadsheets(id),
+                              name text not null,
+                              position int not null,
+                              row_count int not null,
+                              column_count int not null,
+                              conflict_policy text not null default 'REJECT_ON_CONFLICT',
+                              created_at timestamp default current_timestamp,
+                              updated_at timestamp default current_timestamp,
+                              deleted_at timestamp
+);
+
 create table cells (
                               id serial primary key,
                               spreadsheet_id int not null references spreadsheets(id),
+                              sheet_id int not null references sheets(id),
                               raw_value text not null,
                               computed_value text,
+                              computed_type text,
+                              error_message text,
                               row_index int not null,
                               column_index int not null,
                               created_at timestamp default current_timestamp,
                               updated_at timestamp default current_timestamp,
                               deleted_at timestamp,
-                              version bigint default 1
-);
\ No newline at end of file
+                              version bigint default 1,
+                              deleted_version bigint
+);
+create index cells_sheet_position on cells (sheet_id, row_index, column_index, version desc) where deleted_at is null;
+create table named_ranges (
+                              id serial primary key,
+                              spreadsheet_id int not null references spreadsheets(id),
+                              name text not null,
+                              reference text not null,
+                              created_at timestamp default current_timestamp,
+                              updated_at timestamp default current_timestamp,
+                              deleted_at timestamp
+);
+create table presences (
+                              spreadsheet_id int not null references spreadsheets(id),
+                              client_id text not null,
+                              name text,
+                              sheet_id int references sheets(id),
+                              selection text,
+                              updated_at timestamp default current_timestamp,
+                              primary key (spreadsheet_id, client_id)
+);
+create table spreadsheet_versions (
+                              spreadsheet_id int not null references spreadsheets(id),
+                              version bigint not null,
+                              author text,
+                              summary text not null,
+                              structural boolean not null default false,
+                              created_at timestamp default current_timestamp,
+                              primary key (spreadsheet_id, version)
+);
+create table sheet_resizes (
+                              spreadsheet_id int not null references spreadsheets(id),
+                              version bigint not null,
+                              sheet_id int not null references sheets(id),
+                              row_count int not null,
+                              column_count int not null,
+                              primary key (spreadsheet_id, version, sheet_id)
+);
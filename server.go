@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
@@ -11,7 +12,6 @@ import (
 	"log"
 	"net/http"
 	"os"
-	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -41,7 +41,7 @@ func Server(es graphql.ExecutableSchema) *handler.Server {
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
 		},
-		KeepAlivePingInterval: 15 * time.Second,
+		KeepAlivePingInterval: common.KeepAliveInterval,
 	})
 
 	srv.AddTransport(transport.Options{})
@@ -81,8 +81,12 @@ func main() {
 	}
 	srv := Server(generated.NewExecutableSchema(generated.Config{Resolvers: &resolvers.Resolver{}}))
 
+	// changes reach the subscribers of every instance through Postgres notifications
+	events := common.NewEventBus()
+	go events.ListenPostgres(context.Background(), db)
 	customCtx := &common.CustomContext{
 		Database: db,
+		Events:   events,
 	}
 
 	http.Handle("/", cors(playground.Handler("GraphQL playground", "/query")))